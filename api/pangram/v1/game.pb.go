@@ -13,6 +13,7 @@ import (
 
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -25,13 +26,16 @@ const (
 type WordResult int32
 
 const (
-	WordResult_ERROR          WordResult = 0
-	WordResult_OK             WordResult = 1
-	WordResult_TOO_SHORT      WordResult = 2
-	WordResult_INVALID_LETTER WordResult = 3
-	WordResult_MISSING_CENTER WordResult = 4
-	WordResult_NOT_IN_DICT    WordResult = 5
-	WordResult_DUPLICATE      WordResult = 6
+	WordResult_ERROR            WordResult = 0
+	WordResult_OK               WordResult = 1
+	WordResult_TOO_SHORT        WordResult = 2
+	WordResult_INVALID_LETTER   WordResult = 3
+	WordResult_MISSING_CENTER   WordResult = 4
+	WordResult_NOT_IN_DICT      WordResult = 5
+	WordResult_DUPLICATE        WordResult = 6
+	WordResult_DICT_UNAVAILABLE WordResult = 7 // returned as an Unavailable status, the word can be sent again
+	WordResult_GAME_OVER        WordResult = 8 // the game ended, no more words are accepted
+	WordResult_TIME_UP          WordResult = 9 // the time of the game ran out, the score is final
 )
 
 // Enum value maps for WordResult.
@@ -44,15 +48,21 @@ var (
 		4: "MISSING_CENTER",
 		5: "NOT_IN_DICT",
 		6: "DUPLICATE",
+		7: "DICT_UNAVAILABLE",
+		8: "GAME_OVER",
+		9: "TIME_UP",
 	}
 	WordResult_value = map[string]int32{
-		"ERROR":          0,
-		"OK":             1,
-		"TOO_SHORT":      2,
-		"INVALID_LETTER": 3,
-		"MISSING_CENTER": 4,
-		"NOT_IN_DICT":    5,
-		"DUPLICATE":      6,
+		"ERROR":            0,
+		"OK":               1,
+		"TOO_SHORT":        2,
+		"INVALID_LETTER":   3,
+		"MISSING_CENTER":   4,
+		"NOT_IN_DICT":      5,
+		"DUPLICATE":        6,
+		"DICT_UNAVAILABLE": 7,
+		"GAME_OVER":        8,
+		"TIME_UP":          9,
 	}
 )
 
@@ -83,9 +93,73 @@ func (WordResult) EnumDescriptor() ([]byte, []int) {
 	return file_pangram_v1_game_proto_rawDescGZIP(), []int{0}
 }
 
+type EventKind int32
+
+const (
+	EventKind_EVENT_UNKNOWN EventKind = 0
+	EventKind_WORD_FOUND    EventKind = 1
+	EventKind_SCORE_CHANGED EventKind = 2
+	EventKind_RANK_UP       EventKind = 3
+	EventKind_PLAYER_JOINED EventKind = 4
+	EventKind_PLAYER_LEFT   EventKind = 5
+	EventKind_GAME_ENDED    EventKind = 6
+)
+
+// Enum value maps for EventKind.
+var (
+	EventKind_name = map[int32]string{
+		0: "EVENT_UNKNOWN",
+		1: "WORD_FOUND",
+		2: "SCORE_CHANGED",
+		3: "RANK_UP",
+		4: "PLAYER_JOINED",
+		5: "PLAYER_LEFT",
+		6: "GAME_ENDED",
+	}
+	EventKind_value = map[string]int32{
+		"EVENT_UNKNOWN": 0,
+		"WORD_FOUND":    1,
+		"SCORE_CHANGED": 2,
+		"RANK_UP":       3,
+		"PLAYER_JOINED": 4,
+		"PLAYER_LEFT":   5,
+		"GAME_ENDED":    6,
+	}
+)
+
+func (x EventKind) Enum() *EventKind {
+	p := new(EventKind)
+	*p = x
+	return p
+}
+
+func (x EventKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_pangram_v1_game_proto_enumTypes[1].Descriptor()
+}
+
+func (EventKind) Type() protoreflect.EnumType {
+	return &file_pangram_v1_game_proto_enumTypes[1]
+}
+
+func (x EventKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventKind.Descriptor instead.
+func (EventKind) EnumDescriptor() ([]byte, []int) {
+	return file_pangram_v1_game_proto_rawDescGZIP(), []int{1}
+}
+
 type CreateGameRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Share         bool                   `protobuf:"varint,2,opt,name=share,proto3" json:"share,omitempty"`    // also create a read-only share token for spectators
+	Options       *GameOptions           `protobuf:"bytes,3,opt,name=options,proto3" json:"options,omitempty"` // unset fields use the server defaults
+	Player        string                 `protobuf:"bytes,4,opt,name=player,proto3" json:"player,omitempty"`   // name of the creator, required by kinds with named players
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -127,19 +201,165 @@ func (x *CreateGameRequest) GetKind() string {
 	return ""
 }
 
+func (x *CreateGameRequest) GetShare() bool {
+	if x != nil {
+		return x.Share
+	}
+	return false
+}
+
+func (x *CreateGameRequest) GetOptions() *GameOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *CreateGameRequest) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+// Rules of a game. Zero values ask for the server default
+type GameOptions struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	MinLength        int32                  `protobuf:"varint,1,opt,name=min_length,json=minLength,proto3" json:"min_length,omitempty"`
+	Scoring          string                 `protobuf:"bytes,2,opt,name=scoring,proto3" json:"scoring,omitempty"`                                // basic or bonus
+	PangramBonus     int32                  `protobuf:"varint,3,opt,name=pangram_bonus,json=pangramBonus,proto3" json:"pangram_bonus,omitempty"` // extra points for a pangram with bonus scoring
+	TimeLimitSeconds int32                  `protobuf:"varint,4,opt,name=time_limit_seconds,json=timeLimitSeconds,proto3" json:"time_limit_seconds,omitempty"`
+	Board            string                 `protobuf:"bytes,5,opt,name=board,proto3" json:"board,omitempty"`                                 // daily, random or a past date YYYY-MM-DD, leave empty with a puzzle number
+	TargetScore      int32                  `protobuf:"varint,6,opt,name=target_score,json=targetScore,proto3" json:"target_score,omitempty"` // the first player to reach it wins a race
+	FirstBonus       int32                  `protobuf:"varint,7,opt,name=first_bonus,json=firstBonus,proto3" json:"first_bonus,omitempty"`    // extra points for the first player to find a word in a race
+	Lives            int32                  `protobuf:"varint,8,opt,name=lives,proto3" json:"lives,omitempty"`                                // misses a hardcore game allows
+	MissPenalty      int32                  `protobuf:"varint,9,opt,name=miss_penalty,json=missPenalty,proto3" json:"miss_penalty,omitempty"` // points a hardcore game takes for every miss
+	Puzzle           int32                  `protobuf:"varint,10,opt,name=puzzle,proto3" json:"puzzle,omitempty"`                             // puzzle number of a past board, 1 is the epoch day. Set in responses to the puzzle played, 0 for random boards
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GameOptions) Reset() {
+	*x = GameOptions{}
+	mi := &file_pangram_v1_game_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameOptions) ProtoMessage() {}
+
+func (x *GameOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_pangram_v1_game_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameOptions.ProtoReflect.Descriptor instead.
+func (*GameOptions) Descriptor() ([]byte, []int) {
+	return file_pangram_v1_game_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GameOptions) GetMinLength() int32 {
+	if x != nil {
+		return x.MinLength
+	}
+	return 0
+}
+
+func (x *GameOptions) GetScoring() string {
+	if x != nil {
+		return x.Scoring
+	}
+	return ""
+}
+
+func (x *GameOptions) GetPangramBonus() int32 {
+	if x != nil {
+		return x.PangramBonus
+	}
+	return 0
+}
+
+func (x *GameOptions) GetTimeLimitSeconds() int32 {
+	if x != nil {
+		return x.TimeLimitSeconds
+	}
+	return 0
+}
+
+func (x *GameOptions) GetBoard() string {
+	if x != nil {
+		return x.Board
+	}
+	return ""
+}
+
+func (x *GameOptions) GetTargetScore() int32 {
+	if x != nil {
+		return x.TargetScore
+	}
+	return 0
+}
+
+func (x *GameOptions) GetFirstBonus() int32 {
+	if x != nil {
+		return x.FirstBonus
+	}
+	return 0
+}
+
+func (x *GameOptions) GetLives() int32 {
+	if x != nil {
+		return x.Lives
+	}
+	return 0
+}
+
+func (x *GameOptions) GetMissPenalty() int32 {
+	if x != nil {
+		return x.MissPenalty
+	}
+	return 0
+}
+
+func (x *GameOptions) GetPuzzle() int32 {
+	if x != nil {
+		return x.Puzzle
+	}
+	return 0
+}
+
 type CreateGameResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Letters       []string               `protobuf:"bytes,3,rep,name=letters,proto3" json:"letters,omitempty"`
 	Center        string                 `protobuf:"bytes,4,opt,name=center,proto3" json:"center,omitempty"`
+	Answers       int32                  `protobuf:"varint,5,opt,name=answers,proto3" json:"answers,omitempty"`                            // number of valid words on the board
+	Pangrams      int32                  `protobuf:"varint,6,opt,name=pangrams,proto3" json:"pangrams,omitempty"`                          // number of answers that use every letter
+	MaxScore      int32                  `protobuf:"varint,7,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`          // score of a game that finds every answer
+	PlayerToken   string                 `protobuf:"bytes,8,opt,name=player_token,json=playerToken,proto3" json:"player_token,omitempty"`  // secret, required to submit words and delete the game
+	ShareToken    string                 `protobuf:"bytes,9,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"`     // read-only, empty unless share was requested
+	Options       *GameOptions           `protobuf:"bytes,10,opt,name=options,proto3" json:"options,omitempty"`                            // rules in effect, with the defaults filled in
+	EndsAt        *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`                // unset when the game has no time limit
+	InviteToken   string                 `protobuf:"bytes,12,opt,name=invite_token,json=inviteToken,proto3" json:"invite_token,omitempty"` // lets friends join a game with named players, empty for other games
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateGameResponse) Reset() {
 	*x = CreateGameResponse{}
-	mi := &file_pangram_v1_game_proto_msgTypes[1]
+	mi := &file_pangram_v1_game_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -151,7 +371,7 @@ func (x *CreateGameResponse) String() string {
 func (*CreateGameResponse) ProtoMessage() {}
 
 func (x *CreateGameResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pangram_v1_game_proto_msgTypes[1]
+	mi := &file_pangram_v1_game_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -164,7 +384,7 @@ func (x *CreateGameResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateGameResponse.ProtoReflect.Descriptor instead.
 func (*CreateGameResponse) Descriptor() ([]byte, []int) {
-	return file_pangram_v1_game_proto_rawDescGZIP(), []int{1}
+	return file_pangram_v1_game_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *CreateGameResponse) GetId() string {
@@ -195,17 +415,75 @@ func (x *CreateGameResponse) GetCenter() string {
 	return ""
 }
 
+func (x *CreateGameResponse) GetAnswers() int32 {
+	if x != nil {
+		return x.Answers
+	}
+	return 0
+}
+
+func (x *CreateGameResponse) GetPangrams() int32 {
+	if x != nil {
+		return x.Pangrams
+	}
+	return 0
+}
+
+func (x *CreateGameResponse) GetMaxScore() int32 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
+
+func (x *CreateGameResponse) GetPlayerToken() string {
+	if x != nil {
+		return x.PlayerToken
+	}
+	return ""
+}
+
+func (x *CreateGameResponse) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
+}
+
+func (x *CreateGameResponse) GetOptions() *GameOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *CreateGameResponse) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *CreateGameResponse) GetInviteToken() string {
+	if x != nil {
+		return x.InviteToken
+	}
+	return ""
+}
+
+// Tokens can be sent in the token field or in the x-game-token metadata
 type SubmitWordRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Word          string                 `protobuf:"bytes,2,opt,name=word,proto3" json:"word,omitempty"`
+	Token         string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SubmitWordRequest) Reset() {
 	*x = SubmitWordRequest{}
-	mi := &file_pangram_v1_game_proto_msgTypes[2]
+	mi := &file_pangram_v1_game_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -217,7 +495,7 @@ func (x *SubmitWordRequest) String() string {
 func (*SubmitWordRequest) ProtoMessage() {}
 
 func (x *SubmitWordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pangram_v1_game_proto_msgTypes[2]
+	mi := &file_pangram_v1_game_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -230,7 +508,7 @@ func (x *SubmitWordRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubmitWordRequest.ProtoReflect.Descriptor instead.
 func (*SubmitWordRequest) Descriptor() ([]byte, []int) {
-	return file_pangram_v1_game_proto_rawDescGZIP(), []int{2}
+	return file_pangram_v1_game_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *SubmitWordRequest) GetId() string {
@@ -247,6 +525,13 @@ func (x *SubmitWordRequest) GetWord() string {
 	return ""
 }
 
+func (x *SubmitWordRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
 type SubmitWordResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
@@ -254,13 +539,29 @@ type SubmitWordResponse struct {
 	Points        int32                  `protobuf:"varint,3,opt,name=points,proto3" json:"points,omitempty"`
 	Total         int32                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
 	Pangram       bool                   `protobuf:"varint,5,opt,name=pangram,proto3" json:"pangram,omitempty"`
+	MaxScore      int32                  `protobuf:"varint,6,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
+	Complete      bool                   `protobuf:"varint,7,opt,name=complete,proto3" json:"complete,omitempty"` // every answer of the board was found
+	Rank          string                 `protobuf:"bytes,8,opt,name=rank,proto3" json:"rank,omitempty"`
+	NextRank      string                 `protobuf:"bytes,9,opt,name=next_rank,json=nextRank,proto3" json:"next_rank,omitempty"` // empty at the top of the ladder
+	PointsToNext  int32                  `protobuf:"varint,10,opt,name=points_to_next,json=pointsToNext,proto3" json:"points_to_next,omitempty"`
+	RankUp        bool                   `protobuf:"varint,11,opt,name=rank_up,json=rankUp,proto3" json:"rank_up,omitempty"`                   // this word crossed a rank threshold
+	Word          string                 `protobuf:"bytes,12,opt,name=word,proto3" json:"word,omitempty"`                                      // the word as the server checked it, trimmed and lower cased
+	BoardPangram  bool                   `protobuf:"varint,13,opt,name=board_pangram,json=boardPangram,proto3" json:"board_pangram,omitempty"` // the word the board was built from
+	Perfect       bool                   `protobuf:"varint,14,opt,name=perfect,proto3" json:"perfect,omitempty"`                               // pangram using every letter exactly once
+	Player        string                 `protobuf:"bytes,15,opt,name=player,proto3" json:"player,omitempty"`                                  // who submitted the word, empty for games without named players
+	PlayerPoints  int32                  `protobuf:"varint,16,opt,name=player_points,json=playerPoints,proto3" json:"player_points,omitempty"` // points of that player after the word
+	FirstBonus    int32                  `protobuf:"varint,17,opt,name=first_bonus,json=firstBonus,proto3" json:"first_bonus,omitempty"`       // first-finder points of a race, already in points
+	GameOver      bool                   `protobuf:"varint,18,opt,name=game_over,json=gameOver,proto3" json:"game_over,omitempty"`             // the game ended, with this word or before it
+	Winner        string                 `protobuf:"bytes,19,opt,name=winner,proto3" json:"winner,omitempty"`                                  // empty when the best players tied
+	Lives         int32                  `protobuf:"varint,20,opt,name=lives,proto3" json:"lives,omitempty"`                                   // lives left in a hardcore game
+	Penalty       int32                  `protobuf:"varint,21,opt,name=penalty,proto3" json:"penalty,omitempty"`                               // points taken for a miss, already out of total
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SubmitWordResponse) Reset() {
 	*x = SubmitWordResponse{}
-	mi := &file_pangram_v1_game_proto_msgTypes[3]
+	mi := &file_pangram_v1_game_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -272,7 +573,7 @@ func (x *SubmitWordResponse) String() string {
 func (*SubmitWordResponse) ProtoMessage() {}
 
 func (x *SubmitWordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pangram_v1_game_proto_msgTypes[3]
+	mi := &file_pangram_v1_game_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -285,7 +586,7 @@ func (x *SubmitWordResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubmitWordResponse.ProtoReflect.Descriptor instead.
 func (*SubmitWordResponse) Descriptor() ([]byte, []int) {
-	return file_pangram_v1_game_proto_rawDescGZIP(), []int{3}
+	return file_pangram_v1_game_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SubmitWordResponse) GetValid() bool {
@@ -323,42 +624,1871 @@ func (x *SubmitWordResponse) GetPangram() bool {
 	return false
 }
 
-var File_pangram_v1_game_proto protoreflect.FileDescriptor
+func (x *SubmitWordResponse) GetMaxScore() int32 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
 
-const file_pangram_v1_game_proto_rawDesc = "" +
-	"\n" +
-	"\x15pangram/v1/game.proto\x12\n" +
-	"pangram.v1\"'\n" +
-	"\x11CreateGameRequest\x12\x12\n" +
-	"\x04kind\x18\x01 \x01(\tR\x04kind\"j\n" +
-	"\x12CreateGameResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
-	"\aletters\x18\x03 \x03(\tR\aletters\x12\x16\n" +
-	"\x06center\x18\x04 \x01(\tR\x06center\"7\n" +
-	"\x11SubmitWordRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04word\x18\x02 \x01(\tR\x04word\"\xa2\x01\n" +
-	"\x12SubmitWordResponse\x12\x14\n" +
-	"\x05valid\x18\x01 \x01(\bR\x05valid\x12.\n" +
-	"\x06reason\x18\x02 \x01(\x0e2\x16.pangram.v1.WordResultR\x06reason\x12\x16\n" +
-	"\x06points\x18\x03 \x01(\x05R\x06points\x12\x14\n" +
-	"\x05total\x18\x04 \x01(\x05R\x05total\x12\x18\n" +
-	"\apangram\x18\x05 \x01(\bR\apangram*v\n" +
-	"\n" +
-	"WordResult\x12\t\n" +
-	"\x05ERROR\x10\x00\x12\x06\n" +
-	"\x02OK\x10\x01\x12\r\n" +
-	"\tTOO_SHORT\x10\x02\x12\x12\n" +
-	"\x0eINVALID_LETTER\x10\x03\x12\x12\n" +
-	"\x0eMISSING_CENTER\x10\x04\x12\x0f\n" +
-	"\vNOT_IN_DICT\x10\x05\x12\r\n" +
-	"\tDUPLICATE\x10\x062\xa7\x01\n" +
-	"\vGameManager\x12K\n" +
-	"\n" +
-	"CreateGame\x12\x1d.pangram.v1.CreateGameRequest\x1a\x1e.pangram.v1.CreateGameResponse\x12K\n" +
+func (x *SubmitWordResponse) GetComplete() bool {
+	if x != nil {
+		return x.Complete
+	}
+	return false
+}
+
+func (x *SubmitWordResponse) GetRank() string {
+	if x != nil {
+		return x.Rank
+	}
+	return ""
+}
+
+func (x *SubmitWordResponse) GetNextRank() string {
+	if x != nil {
+		return x.NextRank
+	}
+	return ""
+}
+
+func (x *SubmitWordResponse) GetPointsToNext() int32 {
+	if x != nil {
+		return x.PointsToNext
+	}
+	return 0
+}
+
+func (x *SubmitWordResponse) GetRankUp() bool {
+	if x != nil {
+		return x.RankUp
+	}
+	return false
+}
+
+func (x *SubmitWordResponse) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+func (x *SubmitWordResponse) GetBoardPangram() bool {
+	if x != nil {
+		return x.BoardPangram
+	}
+	return false
+}
+
+func (x *SubmitWordResponse) GetPerfect() bool {
+	if x != nil {
+		return x.Perfect
+	}
+	return false
+}
+
+func (x *SubmitWordResponse) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+func (x *SubmitWordResponse) GetPlayerPoints() int32 {
+	if x != nil {
+		return x.PlayerPoints
+	}
+	return 0
+}
+
+func (x *SubmitWordResponse) GetFirstBonus() int32 {
+	if x != nil {
+		return x.FirstBonus
+	}
+	return 0
+}
+
+func (x *SubmitWordResponse) GetGameOver() bool {
+	if x != nil {
+		return x.GameOver
+	}
+	return false
+}
+
+func (x *SubmitWordResponse) GetWinner() string {
+	if x != nil {
+		return x.Winner
+	}
+	return ""
+}
+
+func (x *SubmitWordResponse) GetLives() int32 {
+	if x != nil {
+		return x.Lives
+	}
+	return 0
+}
+
+func (x *SubmitWordResponse) GetPenalty() int32 {
+	if x != nil {
+		return x.Penalty
+	}
+	return 0
+}
+
+type GetGameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGameRequest) Reset() {
+	*x = GetGameRequest{}
+	mi := &file_pangram_v1_game_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameRequest) ProtoMessage() {}
+
+func (x *GetGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pangram_v1_game_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameRequest.ProtoReflect.Descriptor instead.
+func (*GetGameRequest) Descriptor() ([]byte, []int) {
+	return file_pangram_v1_game_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetGameRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetGameRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type GetGameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Letters       []string               `protobuf:"bytes,3,rep,name=letters,proto3" json:"letters,omitempty"`
+	Center        string                 `protobuf:"bytes,4,opt,name=center,proto3" json:"center,omitempty"`
+	Found         []string               `protobuf:"bytes,5,rep,name=found,proto3" json:"found,omitempty"` // in the order they were found
+	Total         int32                  `protobuf:"varint,6,opt,name=total,proto3" json:"total,omitempty"`
+	Rank          string                 `protobuf:"bytes,7,opt,name=rank,proto3" json:"rank,omitempty"`
+	NextRank      string                 `protobuf:"bytes,8,opt,name=next_rank,json=nextRank,proto3" json:"next_rank,omitempty"`
+	PointsToNext  int32                  `protobuf:"varint,9,opt,name=points_to_next,json=pointsToNext,proto3" json:"points_to_next,omitempty"`
+	Answers       int32                  `protobuf:"varint,10,opt,name=answers,proto3" json:"answers,omitempty"`
+	Pangrams      int32                  `protobuf:"varint,11,opt,name=pangrams,proto3" json:"pangrams,omitempty"`
+	MaxScore      int32                  `protobuf:"varint,12,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ReadOnly      bool                   `protobuf:"varint,14,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"` // the game was read with the share token
+	Options       *GameOptions           `protobuf:"bytes,15,opt,name=options,proto3" json:"options,omitempty"`
+	Players       []*Player              `protobuf:"bytes,16,rep,name=players,proto3" json:"players,omitempty"` // empty for games without named players
+	Player        string                 `protobuf:"bytes,17,opt,name=player,proto3" json:"player,omitempty"`   // player the token belongs to
+	Ended         bool                   `protobuf:"varint,18,opt,name=ended,proto3" json:"ended,omitempty"`
+	Winner        string                 `protobuf:"bytes,19,opt,name=winner,proto3" json:"winner,omitempty"`               // empty while playing or when the best players tied
+	EndsAt        *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"` // unset when the game has no time limit
+	Lives         int32                  `protobuf:"varint,21,opt,name=lives,proto3" json:"lives,omitempty"`                // lives left in a hardcore game
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGameResponse) Reset() {
+	*x = GetGameResponse{}
+	mi := &file_pangram_v1_game_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameResponse) ProtoMessage() {}
+
+func (x *GetGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pangram_v1_game_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameResponse.ProtoReflect.Descriptor instead.
+func (*GetGameResponse) Descriptor() ([]byte, []int) {
+	return file_pangram_v1_game_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetGameResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetGameResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetGameResponse) GetLetters() []string {
+	if x != nil {
+		return x.Letters
+	}
+	return nil
+}
+
+func (x *GetGameResponse) GetCenter() string {
+	if x != nil {
+		return x.Center
+	}
+	return ""
+}
+
+func (x *GetGameResponse) GetFound() []string {
+	if x != nil {
+		return x.Found
+	}
+	return nil
+}
+
+func (x *GetGameResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetGameResponse) GetRank() string {
+	if x != nil {
+		return x.Rank
+	}
+	return ""
+}
+
+func (x *GetGameResponse) GetNextRank() string {
+	if x != nil {
+		return x.NextRank
+	}
+	return ""
+}
+
+func (x *GetGameResponse) GetPointsToNext() int32 {
+	if x != nil {
+		return x.PointsToNext
+	}
+	return 0
+}
+
+func (x *GetGameResponse) GetAnswers() int32 {
+	if x != nil {
+		return x.Answers
+	}
+	return 0
+}
+
+func (x *GetGameResponse) GetPangrams() int32 {
+	if x != nil {
+		return x.Pangrams
+	}
+	return 0
+}
+
+func (x *GetGameResponse) GetMaxScore() int32 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
+
+func (x *GetGameResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *GetGameResponse) GetReadOnly() bool {
+	if x != nil {
+		return x.ReadOnly
+	}
+	return false
+}
+
+func (x *GetGameResponse) GetOptions() *GameOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *GetGameResponse) GetPlayers() []*Player {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *GetGameResponse) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+func (x *GetGameResponse) GetEnded() bool {
+	if x != nil {
+		return x.Ended
+	}
+	return false
+}
+
+func (x *GetGameResponse) GetWinner() string {
+	if x != nil {
+		return x.Winner
+	}
+	return ""
+}
+
+func (x *GetGameResponse) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *GetGameResponse) GetLives() int32 {
+	if x != nil {
+		return x.Lives
+	}
+	return 0
+}
+
+type Player struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Found         []string               `protobuf:"bytes,2,rep,name=found,proto3" json:"found,omitempty"` // words this player found, the words of race opponents are hidden until it ends
+	Points        int32                  `protobuf:"varint,3,opt,name=points,proto3" json:"points,omitempty"`
+	Words         int32                  `protobuf:"varint,4,opt,name=words,proto3" json:"words,omitempty"`
+	Bonus         int32                  `protobuf:"varint,5,opt,name=bonus,proto3" json:"bonus,omitempty"` // first-finder points, already in points
+	Left          bool                   `protobuf:"varint,6,opt,name=left,proto3" json:"left,omitempty"`   // left the game, the words and points stay
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Player) Reset() {
+	*x = Player{}
+	mi := &file_pangram_v1_game_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Player) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Player) ProtoMessage() {}
+
+func (x *Player) ProtoReflect() protoreflect.Message {
+	mi := &file_pangram_v1_game_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Player.ProtoReflect.Descriptor instead.
+func (*Player) Descriptor() ([]byte, []int) {
+	return file_pangram_v1_game_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Player) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Player) GetFound() []string {
+	if x != nil {
+		return x.Found
+	}
+	return nil
+}
+
+func (x *Player) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *Player) GetWords() int32 {
+	if x != nil {
+		return x.Words
+	}
+	return 0
+}
+
+func (x *Player) GetBonus() int32 {
+	if x != nil {
+		return x.Bonus
+	}
+	return 0
+}
+
+func (x *Player) GetLeft() bool {
+	if x != nil {
+		return x.Left
+	}
+	return false
+}
+
+// Join a game with named players with its invite token (or the token of the creator), the response has the token of the new player
+type JoinGameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	Player        string                 `protobuf:"bytes,3,opt,name=player,proto3" json:"player,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinGameRequest) Reset() {
+	*x = JoinGameRequest{}
+	mi := &file_pangram_v1_game_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinGameRequest) ProtoMessage() {}
+
+func (x *JoinGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pangram_v1_game_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinGameRequest.ProtoReflect.Descriptor instead.
+func (*JoinGameRequest) Descriptor() ([]byte, []int) {
+	return file_pangram_v1_game_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *JoinGameRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *JoinGameRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *JoinGameRequest) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+type JoinGameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerToken   string                 `protobuf:"bytes,1,opt,name=player_token,json=playerToken,proto3" json:"player_token,omitempty"`
+	Player        string                 `protobuf:"bytes,2,opt,name=player,proto3" json:"player,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinGameResponse) Reset() {
+	*x = JoinGameResponse{}
+	mi := &file_pangram_v1_game_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinGameResponse) ProtoMessage() {}
+
+func (x *JoinGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pangram_v1_game_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinGameResponse.ProtoReflect.Descriptor instead.
+func (*JoinGameResponse) Descriptor() ([]byte, []int) {
+	return file_pangram_v1_game_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *JoinGameResponse) GetPlayerToken() string {
+	if x != nil {
+		return x.PlayerToken
+	}
+	return ""
+}
+
+func (x *JoinGameResponse) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+// Leave a game with named players, the token stops working but the words and points stay
+type LeaveGameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveGameRequest) Reset() {
+	*x = LeaveGameRequest{}
+	mi := &file_pangram_v1_game_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveGameRequest) ProtoMessage() {}
+
+func (x *LeaveGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pangram_v1_game_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveGameRequest.ProtoReflect.Descriptor instead.
+func (*LeaveGameRequest) Descriptor() ([]byte, []int) {
+	return file_pangram_v1_game_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *LeaveGameRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LeaveGameRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type LeaveGameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveGameResponse) Reset() {
+	*x = LeaveGameResponse{}
+	mi := &file_pangram_v1_game_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveGameResponse) ProtoMessage() {}
+
+func (x *LeaveGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pangram_v1_game_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveGameResponse.ProtoReflect.Descriptor instead.
+func (*LeaveGameResponse) Descriptor() ([]byte, []int) {
+	return file_pangram_v1_game_proto_rawDescGZIP(), []int{11}
+}
+
+// Any token of the game can watch it. A watcher that falls behind is disconnected with RESOURCE_EXHAUSTED and can watch again
+type WatchGameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchGameRequest) Reset() {
+	*x = WatchGameRequest{}
+	mi := &file_pangram_v1_game_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchGameRequest) ProtoMessage() {}
+
+func (x *WatchGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pangram_v1_game_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchGameRequest.ProtoReflect.Descriptor instead.
+func (*WatchGameRequest) Descriptor() ([]byte, []int) {
+	return file_pangram_v1_game_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *WatchGameRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WatchGameRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type GameEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          EventKind              `protobuf:"varint,1,opt,name=kind,proto3,enum=pangram.v1.EventKind" json:"kind,omitempty"`
+	Player        string                 `protobuf:"bytes,2,opt,name=player,proto3" json:"player,omitempty"`
+	Word          string                 `protobuf:"bytes,3,opt,name=word,proto3" json:"word,omitempty"` // empty for race opponents until the race ends
+	Points        int32                  `protobuf:"varint,4,opt,name=points,proto3" json:"points,omitempty"`
+	Pangram       bool                   `protobuf:"varint,5,opt,name=pangram,proto3" json:"pangram,omitempty"`
+	Total         int32                  `protobuf:"varint,6,opt,name=total,proto3" json:"total,omitempty"` // the team total in co-op, the player total in a race
+	Rank          string                 `protobuf:"bytes,7,opt,name=rank,proto3" json:"rank,omitempty"`
+	Winner        string                 `protobuf:"bytes,8,opt,name=winner,proto3" json:"winner,omitempty"`
+	At            *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=at,proto3" json:"at,omitempty"`
+	PlayerPoints  int32                  `protobuf:"varint,10,opt,name=player_points,json=playerPoints,proto3" json:"player_points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameEvent) Reset() {
+	*x = GameEvent{}
+	mi := &file_pangram_v1_game_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameEvent) ProtoMessage() {}
+
+func (x *GameEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_pangram_v1_game_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameEvent.ProtoReflect.Descriptor instead.
+func (*GameEvent) Descriptor() ([]byte, []int) {
+	return file_pangram_v1_game_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GameEvent) GetKind() EventKind {
+	if x != nil {
+		return x.Kind
+	}
+	return EventKind_EVENT_UNKNOWN
+}
+
+func (x *GameEvent) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+func (x *GameEvent) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+func (x *GameEvent) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *GameEvent) GetPangram() bool {
+	if x != nil {
+		return x.Pangram
+	}
+	return false
+}
+
+func (x *GameEvent) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GameEvent) GetRank() string {
+	if x != nil {
+		return x.Rank
+	}
+	return ""
+}
+
+func (x *GameEvent) GetWinner() string {
+	if x != nil {
+		return x.Winner
+	}
+	return ""
+}
+
+func (x *GameEvent) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+func (x *GameEvent) GetPlayerPoints() int32 {
+	if x != nil {
+		return x.PlayerPoints
+	}
+	return 0
+}
+
+// Counts of the words left to find, never the words themselves. Any token of the game can ask
+type GetHintsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHintsRequest) Reset() {
+	*x = GetHintsRequest{}
+	mi := &file_pangram_v1_game_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHintsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHintsRequest) ProtoMessage() {}
+
+func (x *GetHintsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pangram_v1_game_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHintsRequest.ProtoReflect.Descriptor instead.
+func (*GetHintsRequest) Descriptor() ([]byte, []int) {
+	return file_pangram_v1_game_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetHintsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetHintsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type GetHintsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Words         int32                  `protobuf:"varint,1,opt,name=words,proto3" json:"words,omitempty"`            // words left to find
+	Pangrams      int32                  `protobuf:"varint,2,opt,name=pangrams,proto3" json:"pangrams,omitempty"`      // pangrams left to find
+	Lengths       []int32                `protobuf:"varint,3,rep,packed,name=lengths,proto3" json:"lengths,omitempty"` // word lengths with words left, the columns of the grid
+	Rows          []*HintRow             `protobuf:"bytes,4,rep,name=rows,proto3" json:"rows,omitempty"`               // one row per first letter, in alphabetical order
+	Prefixes      []*PrefixCount         `protobuf:"bytes,5,rep,name=prefixes,proto3" json:"prefixes,omitempty"`       // words left by their first two letters, in alphabetical order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHintsResponse) Reset() {
+	*x = GetHintsResponse{}
+	mi := &file_pangram_v1_game_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHintsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHintsResponse) ProtoMessage() {}
+
+func (x *GetHintsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pangram_v1_game_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHintsResponse.ProtoReflect.Descriptor instead.
+func (*GetHintsResponse) Descriptor() ([]byte, []int) {
+	return file_pangram_v1_game_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetHintsResponse) GetWords() int32 {
+	if x != nil {
+		return x.Words
+	}
+	return 0
+}
+
+func (x *GetHintsResponse) GetPangrams() int32 {
+	if x != nil {
+		return x.Pangrams
+	}
+	return 0
+}
+
+func (x *GetHintsResponse) GetLengths() []int32 {
+	if x != nil {
+		return x.Lengths
+	}
+	return nil
+}
+
+func (x *GetHintsResponse) GetRows() []*HintRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *GetHintsResponse) GetPrefixes() []*PrefixCount {
+	if x != nil {
+		return x.Prefixes
+	}
+	return nil
+}
+
+type HintRow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Letter        string                 `protobuf:"bytes,1,opt,name=letter,proto3" json:"letter,omitempty"`
+	ByLength      map[int32]int32        `protobuf:"bytes,2,rep,name=by_length,json=byLength,proto3" json:"by_length,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // word length to words left
+	Total         int32                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HintRow) Reset() {
+	*x = HintRow{}
+	mi := &file_pangram_v1_game_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HintRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HintRow) ProtoMessage() {}
+
+func (x *HintRow) ProtoReflect() protoreflect.Message {
+	mi := &file_pangram_v1_game_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HintRow.ProtoReflect.Descriptor instead.
+func (*HintRow) Descriptor() ([]byte, []int) {
+	return file_pangram_v1_game_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *HintRow) GetLetter() string {
+	if x != nil {
+		return x.Letter
+	}
+	return ""
+}
+
+func (x *HintRow) GetByLength() map[int32]int32 {
+	if x != nil {
+		return x.ByLength
+	}
+	return nil
+}
+
+func (x *HintRow) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type PrefixCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prefix        string                 `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrefixCount) Reset() {
+	*x = PrefixCount{}
+	mi := &file_pangram_v1_game_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrefixCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrefixCount) ProtoMessage() {}
+
+func (x *PrefixCount) ProtoReflect() protoreflect.Message {
+	mi := &file_pangram_v1_game_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrefixCount.ProtoReflect.Descriptor instead.
+func (*PrefixCount) Descriptor() ([]byte, []int) {
+	return file_pangram_v1_game_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PrefixCount) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *PrefixCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// Every answer of a game, only for games that ended, were given up with EndGame, or whose daily board rotated since they started. Otherwise FAILED_PRECONDITION with ANSWERS_HIDDEN
+type RevealAnswersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevealAnswersRequest) Reset() {
+	*x = RevealAnswersRequest{}
+	mi := &file_pangram_v1_game_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevealAnswersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevealAnswersRequest) ProtoMessage() {}
+
+func (x *RevealAnswersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pangram_v1_game_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevealAnswersRequest.ProtoReflect.Descriptor instead.
+func (*RevealAnswersRequest) Descriptor() ([]byte, []int) {
+	return file_pangram_v1_game_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RevealAnswersRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RevealAnswersRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type RevealAnswersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Found         []*Answer              `protobuf:"bytes,1,rep,name=found,proto3" json:"found,omitempty"` // in the order they were found
+	Missed        []*Answer              `protobuf:"bytes,2,rep,name=missed,proto3" json:"missed,omitempty"`
+	FoundPoints   int32                  `protobuf:"varint,3,opt,name=found_points,json=foundPoints,proto3" json:"found_points,omitempty"`
+	MissedPoints  int32                  `protobuf:"varint,4,opt,name=missed_points,json=missedPoints,proto3" json:"missed_points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevealAnswersResponse) Reset() {
+	*x = RevealAnswersResponse{}
+	mi := &file_pangram_v1_game_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevealAnswersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevealAnswersResponse) ProtoMessage() {}
+
+func (x *RevealAnswersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pangram_v1_game_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevealAnswersResponse.ProtoReflect.Descriptor instead.
+func (*RevealAnswersResponse) Descriptor() ([]byte, []int) {
+	return file_pangram_v1_game_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RevealAnswersResponse) GetFound() []*Answer {
+	if x != nil {
+		return x.Found
+	}
+	return nil
+}
+
+func (x *RevealAnswersResponse) GetMissed() []*Answer {
+	if x != nil {
+		return x.Missed
+	}
+	return nil
+}
+
+func (x *RevealAnswersResponse) GetFoundPoints() int32 {
+	if x != nil {
+		return x.FoundPoints
+	}
+	return 0
+}
+
+func (x *RevealAnswersResponse) GetMissedPoints() int32 {
+	if x != nil {
+		return x.MissedPoints
+	}
+	return 0
+}
+
+type Answer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Word          string                 `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"`
+	Points        int32                  `protobuf:"varint,2,opt,name=points,proto3" json:"points,omitempty"` // word points under the rules of the game
+	Pangram       bool                   `protobuf:"varint,3,opt,name=pangram,proto3" json:"pangram,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Answer) Reset() {
+	*x = Answer{}
+	mi := &file_pangram_v1_game_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Answer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Answer) ProtoMessage() {}
+
+func (x *Answer) ProtoReflect() protoreflect.Message {
+	mi := &file_pangram_v1_game_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Answer.ProtoReflect.Descriptor instead.
+func (*Answer) Descriptor() ([]byte, []int) {
+	return file_pangram_v1_game_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Answer) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+func (x *Answer) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *Answer) GetPangram() bool {
+	if x != nil {
+		return x.Pangram
+	}
+	return false
+}
+
+type DeleteGameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGameRequest) Reset() {
+	*x = DeleteGameRequest{}
+	mi := &file_pangram_v1_game_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGameRequest) ProtoMessage() {}
+
+func (x *DeleteGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pangram_v1_game_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGameRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGameRequest) Descriptor() ([]byte, []int) {
+	return file_pangram_v1_game_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeleteGameRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteGameRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type DeleteGameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGameResponse) Reset() {
+	*x = DeleteGameResponse{}
+	mi := &file_pangram_v1_game_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGameResponse) ProtoMessage() {}
+
+func (x *DeleteGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pangram_v1_game_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGameResponse.ProtoReflect.Descriptor instead.
+func (*DeleteGameResponse) Descriptor() ([]byte, []int) {
+	return file_pangram_v1_game_proto_rawDescGZIP(), []int{22}
+}
+
+// Give up a game that has no end of its own (single player and co-op), only with the player token of its creator. Other games answer FAILED_PRECONDITION with GAME_ENDS_ON_ITS_OWN
+type EndGameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EndGameRequest) Reset() {
+	*x = EndGameRequest{}
+	mi := &file_pangram_v1_game_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndGameRequest) ProtoMessage() {}
+
+func (x *EndGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pangram_v1_game_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndGameRequest.ProtoReflect.Descriptor instead.
+func (*EndGameRequest) Descriptor() ([]byte, []int) {
+	return file_pangram_v1_game_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *EndGameRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EndGameRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type EndGameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EndGameResponse) Reset() {
+	*x = EndGameResponse{}
+	mi := &file_pangram_v1_game_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndGameResponse) ProtoMessage() {}
+
+func (x *EndGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pangram_v1_game_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndGameResponse.ProtoReflect.Descriptor instead.
+func (*EndGameResponse) Descriptor() ([]byte, []int) {
+	return file_pangram_v1_game_proto_rawDescGZIP(), []int{24}
+}
+
+type ListGameKindsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGameKindsRequest) Reset() {
+	*x = ListGameKindsRequest{}
+	mi := &file_pangram_v1_game_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGameKindsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGameKindsRequest) ProtoMessage() {}
+
+func (x *ListGameKindsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pangram_v1_game_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGameKindsRequest.ProtoReflect.Descriptor instead.
+func (*ListGameKindsRequest) Descriptor() ([]byte, []int) {
+	return file_pangram_v1_game_proto_rawDescGZIP(), []int{25}
+}
+
+type ListGameKindsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kinds         []*GameKind            `protobuf:"bytes,1,rep,name=kinds,proto3" json:"kinds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGameKindsResponse) Reset() {
+	*x = ListGameKindsResponse{}
+	mi := &file_pangram_v1_game_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGameKindsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGameKindsResponse) ProtoMessage() {}
+
+func (x *ListGameKindsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pangram_v1_game_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGameKindsResponse.ProtoReflect.Descriptor instead.
+func (*ListGameKindsResponse) Descriptor() ([]byte, []int) {
+	return file_pangram_v1_game_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListGameKindsResponse) GetKinds() []*GameKind {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+type GameKind struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Options       []*GameOption          `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty"`
+	Available     bool                   `protobuf:"varint,4,opt,name=available,proto3" json:"available,omitempty"` // false for kinds that are listed but can not be created yet
+	Players       bool                   `protobuf:"varint,5,opt,name=players,proto3" json:"players,omitempty"`     // played by named players, CreateGame needs a player name
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameKind) Reset() {
+	*x = GameKind{}
+	mi := &file_pangram_v1_game_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameKind) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameKind) ProtoMessage() {}
+
+func (x *GameKind) ProtoReflect() protoreflect.Message {
+	mi := &file_pangram_v1_game_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameKind.ProtoReflect.Descriptor instead.
+func (*GameKind) Descriptor() ([]byte, []int) {
+	return file_pangram_v1_game_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GameKind) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GameKind) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *GameKind) GetOptions() []*GameOption {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *GameKind) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *GameKind) GetPlayers() bool {
+	if x != nil {
+		return x.Players
+	}
+	return false
+}
+
+type GameOption struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"` // int, string, bool or duration
+	DefaultValue  string                 `protobuf:"bytes,3,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameOption) Reset() {
+	*x = GameOption{}
+	mi := &file_pangram_v1_game_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameOption) ProtoMessage() {}
+
+func (x *GameOption) ProtoReflect() protoreflect.Message {
+	mi := &file_pangram_v1_game_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameOption.ProtoReflect.Descriptor instead.
+func (*GameOption) Descriptor() ([]byte, []int) {
+	return file_pangram_v1_game_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GameOption) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GameOption) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *GameOption) GetDefaultValue() string {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return ""
+}
+
+func (x *GameOption) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+var File_pangram_v1_game_proto protoreflect.FileDescriptor
+
+const file_pangram_v1_game_proto_rawDesc = "" +
+	"\n" +
+	"\x15pangram/v1/game.proto\x12\n" +
+	"pangram.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x88\x01\n" +
+	"\x11CreateGameRequest\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05share\x18\x02 \x01(\bR\x05share\x121\n" +
+	"\aoptions\x18\x03 \x01(\v2\x17.pangram.v1.GameOptionsR\aoptions\x12\x16\n" +
+	"\x06player\x18\x04 \x01(\tR\x06player\"\xc4\x02\n" +
+	"\vGameOptions\x12\x1d\n" +
+	"\n" +
+	"min_length\x18\x01 \x01(\x05R\tminLength\x12\x18\n" +
+	"\ascoring\x18\x02 \x01(\tR\ascoring\x12#\n" +
+	"\rpangram_bonus\x18\x03 \x01(\x05R\fpangramBonus\x12,\n" +
+	"\x12time_limit_seconds\x18\x04 \x01(\x05R\x10timeLimitSeconds\x12\x14\n" +
+	"\x05board\x18\x05 \x01(\tR\x05board\x12!\n" +
+	"\ftarget_score\x18\x06 \x01(\x05R\vtargetScore\x12\x1f\n" +
+	"\vfirst_bonus\x18\a \x01(\x05R\n" +
+	"firstBonus\x12\x14\n" +
+	"\x05lives\x18\b \x01(\x05R\x05lives\x12!\n" +
+	"\fmiss_penalty\x18\t \x01(\x05R\vmissPenalty\x12\x16\n" +
+	"\x06puzzle\x18\n" +
+	" \x01(\x05R\x06puzzle\"\x8c\x03\n" +
+	"\x12CreateGameResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\aletters\x18\x03 \x03(\tR\aletters\x12\x16\n" +
+	"\x06center\x18\x04 \x01(\tR\x06center\x12\x18\n" +
+	"\aanswers\x18\x05 \x01(\x05R\aanswers\x12\x1a\n" +
+	"\bpangrams\x18\x06 \x01(\x05R\bpangrams\x12\x1b\n" +
+	"\tmax_score\x18\a \x01(\x05R\bmaxScore\x12!\n" +
+	"\fplayer_token\x18\b \x01(\tR\vplayerToken\x12\x1f\n" +
+	"\vshare_token\x18\t \x01(\tR\n" +
+	"shareToken\x121\n" +
+	"\aoptions\x18\n" +
+	" \x01(\v2\x17.pangram.v1.GameOptionsR\aoptions\x123\n" +
+	"\aends_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12!\n" +
+	"\finvite_token\x18\f \x01(\tR\vinviteToken\"M\n" +
+	"\x11SubmitWordRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04word\x18\x02 \x01(\tR\x04word\x12\x14\n" +
+	"\x05token\x18\x03 \x01(\tR\x05token\"\xe1\x04\n" +
+	"\x12SubmitWordResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12.\n" +
+	"\x06reason\x18\x02 \x01(\x0e2\x16.pangram.v1.WordResultR\x06reason\x12\x16\n" +
+	"\x06points\x18\x03 \x01(\x05R\x06points\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x05R\x05total\x12\x18\n" +
+	"\apangram\x18\x05 \x01(\bR\apangram\x12\x1b\n" +
+	"\tmax_score\x18\x06 \x01(\x05R\bmaxScore\x12\x1a\n" +
+	"\bcomplete\x18\a \x01(\bR\bcomplete\x12\x12\n" +
+	"\x04rank\x18\b \x01(\tR\x04rank\x12\x1b\n" +
+	"\tnext_rank\x18\t \x01(\tR\bnextRank\x12$\n" +
+	"\x0epoints_to_next\x18\n" +
+	" \x01(\x05R\fpointsToNext\x12\x17\n" +
+	"\arank_up\x18\v \x01(\bR\x06rankUp\x12\x12\n" +
+	"\x04word\x18\f \x01(\tR\x04word\x12#\n" +
+	"\rboard_pangram\x18\r \x01(\bR\fboardPangram\x12\x18\n" +
+	"\aperfect\x18\x0e \x01(\bR\aperfect\x12\x16\n" +
+	"\x06player\x18\x0f \x01(\tR\x06player\x12#\n" +
+	"\rplayer_points\x18\x10 \x01(\x05R\fplayerPoints\x12\x1f\n" +
+	"\vfirst_bonus\x18\x11 \x01(\x05R\n" +
+	"firstBonus\x12\x1b\n" +
+	"\tgame_over\x18\x12 \x01(\bR\bgameOver\x12\x16\n" +
+	"\x06winner\x18\x13 \x01(\tR\x06winner\x12\x14\n" +
+	"\x05lives\x18\x14 \x01(\x05R\x05lives\x12\x18\n" +
+	"\apenalty\x18\x15 \x01(\x05R\apenalty\"6\n" +
+	"\x0eGetGameRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"\x87\x05\n" +
+	"\x0fGetGameResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\aletters\x18\x03 \x03(\tR\aletters\x12\x16\n" +
+	"\x06center\x18\x04 \x01(\tR\x06center\x12\x14\n" +
+	"\x05found\x18\x05 \x03(\tR\x05found\x12\x14\n" +
+	"\x05total\x18\x06 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04rank\x18\a \x01(\tR\x04rank\x12\x1b\n" +
+	"\tnext_rank\x18\b \x01(\tR\bnextRank\x12$\n" +
+	"\x0epoints_to_next\x18\t \x01(\x05R\fpointsToNext\x12\x18\n" +
+	"\aanswers\x18\n" +
+	" \x01(\x05R\aanswers\x12\x1a\n" +
+	"\bpangrams\x18\v \x01(\x05R\bpangrams\x12\x1b\n" +
+	"\tmax_score\x18\f \x01(\x05R\bmaxScore\x129\n" +
+	"\n" +
+	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1b\n" +
+	"\tread_only\x18\x0e \x01(\bR\breadOnly\x121\n" +
+	"\aoptions\x18\x0f \x01(\v2\x17.pangram.v1.GameOptionsR\aoptions\x12,\n" +
+	"\aplayers\x18\x10 \x03(\v2\x12.pangram.v1.PlayerR\aplayers\x12\x16\n" +
+	"\x06player\x18\x11 \x01(\tR\x06player\x12\x14\n" +
+	"\x05ended\x18\x12 \x01(\bR\x05ended\x12\x16\n" +
+	"\x06winner\x18\x13 \x01(\tR\x06winner\x123\n" +
+	"\aends_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12\x14\n" +
+	"\x05lives\x18\x15 \x01(\x05R\x05lives\"\x8a\x01\n" +
+	"\x06Player\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05found\x18\x02 \x03(\tR\x05found\x12\x16\n" +
+	"\x06points\x18\x03 \x01(\x05R\x06points\x12\x14\n" +
+	"\x05words\x18\x04 \x01(\x05R\x05words\x12\x14\n" +
+	"\x05bonus\x18\x05 \x01(\x05R\x05bonus\x12\x12\n" +
+	"\x04left\x18\x06 \x01(\bR\x04left\"O\n" +
+	"\x0fJoinGameRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x12\x16\n" +
+	"\x06player\x18\x03 \x01(\tR\x06player\"M\n" +
+	"\x10JoinGameResponse\x12!\n" +
+	"\fplayer_token\x18\x01 \x01(\tR\vplayerToken\x12\x16\n" +
+	"\x06player\x18\x02 \x01(\tR\x06player\"8\n" +
+	"\x10LeaveGameRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"\x13\n" +
+	"\x11LeaveGameResponse\"8\n" +
+	"\x10WatchGameRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"\xa7\x02\n" +
+	"\tGameEvent\x12)\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x15.pangram.v1.EventKindR\x04kind\x12\x16\n" +
+	"\x06player\x18\x02 \x01(\tR\x06player\x12\x12\n" +
+	"\x04word\x18\x03 \x01(\tR\x04word\x12\x16\n" +
+	"\x06points\x18\x04 \x01(\x05R\x06points\x12\x18\n" +
+	"\apangram\x18\x05 \x01(\bR\apangram\x12\x14\n" +
+	"\x05total\x18\x06 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04rank\x18\a \x01(\tR\x04rank\x12\x16\n" +
+	"\x06winner\x18\b \x01(\tR\x06winner\x12*\n" +
+	"\x02at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x02at\x12#\n" +
+	"\rplayer_points\x18\n" +
+	" \x01(\x05R\fplayerPoints\"7\n" +
+	"\x0fGetHintsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"\xbc\x01\n" +
+	"\x10GetHintsResponse\x12\x14\n" +
+	"\x05words\x18\x01 \x01(\x05R\x05words\x12\x1a\n" +
+	"\bpangrams\x18\x02 \x01(\x05R\bpangrams\x12\x18\n" +
+	"\alengths\x18\x03 \x03(\x05R\alengths\x12'\n" +
+	"\x04rows\x18\x04 \x03(\v2\x13.pangram.v1.HintRowR\x04rows\x123\n" +
+	"\bprefixes\x18\x05 \x03(\v2\x17.pangram.v1.PrefixCountR\bprefixes\"\xb4\x01\n" +
+	"\aHintRow\x12\x16\n" +
+	"\x06letter\x18\x01 \x01(\tR\x06letter\x12>\n" +
+	"\tby_length\x18\x02 \x03(\v2!.pangram.v1.HintRow.ByLengthEntryR\bbyLength\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x05R\x05total\x1a;\n" +
+	"\rByLengthEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\";\n" +
+	"\vPrefixCount\x12\x16\n" +
+	"\x06prefix\x18\x01 \x01(\tR\x06prefix\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"<\n" +
+	"\x14RevealAnswersRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"\xb5\x01\n" +
+	"\x15RevealAnswersResponse\x12(\n" +
+	"\x05found\x18\x01 \x03(\v2\x12.pangram.v1.AnswerR\x05found\x12*\n" +
+	"\x06missed\x18\x02 \x03(\v2\x12.pangram.v1.AnswerR\x06missed\x12!\n" +
+	"\ffound_points\x18\x03 \x01(\x05R\vfoundPoints\x12#\n" +
+	"\rmissed_points\x18\x04 \x01(\x05R\fmissedPoints\"N\n" +
+	"\x06Answer\x12\x12\n" +
+	"\x04word\x18\x01 \x01(\tR\x04word\x12\x16\n" +
+	"\x06points\x18\x02 \x01(\x05R\x06points\x12\x18\n" +
+	"\apangram\x18\x03 \x01(\bR\apangram\"9\n" +
+	"\x11DeleteGameRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"\x14\n" +
+	"\x12DeleteGameResponse\"6\n" +
+	"\x0eEndGameRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"\x11\n" +
+	"\x0fEndGameResponse\"\x16\n" +
+	"\x14ListGameKindsRequest\"C\n" +
+	"\x15ListGameKindsResponse\x12*\n" +
+	"\x05kinds\x18\x01 \x03(\v2\x14.pangram.v1.GameKindR\x05kinds\"\xaa\x01\n" +
+	"\bGameKind\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x120\n" +
+	"\aoptions\x18\x03 \x03(\v2\x16.pangram.v1.GameOptionR\aoptions\x12\x1c\n" +
+	"\tavailable\x18\x04 \x01(\bR\tavailable\x12\x18\n" +
+	"\aplayers\x18\x05 \x01(\bR\aplayers\"{\n" +
+	"\n" +
+	"GameOption\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12#\n" +
+	"\rdefault_value\x18\x03 \x01(\tR\fdefaultValue\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription*\xa8\x01\n" +
+	"\n" +
+	"WordResult\x12\t\n" +
+	"\x05ERROR\x10\x00\x12\x06\n" +
+	"\x02OK\x10\x01\x12\r\n" +
+	"\tTOO_SHORT\x10\x02\x12\x12\n" +
+	"\x0eINVALID_LETTER\x10\x03\x12\x12\n" +
+	"\x0eMISSING_CENTER\x10\x04\x12\x0f\n" +
+	"\vNOT_IN_DICT\x10\x05\x12\r\n" +
+	"\tDUPLICATE\x10\x06\x12\x14\n" +
+	"\x10DICT_UNAVAILABLE\x10\a\x12\r\n" +
+	"\tGAME_OVER\x10\b\x12\v\n" +
+	"\aTIME_UP\x10\t*\x82\x01\n" +
+	"\tEventKind\x12\x11\n" +
+	"\rEVENT_UNKNOWN\x10\x00\x12\x0e\n" +
+	"\n" +
+	"WORD_FOUND\x10\x01\x12\x11\n" +
+	"\rSCORE_CHANGED\x10\x02\x12\v\n" +
+	"\aRANK_UP\x10\x03\x12\x11\n" +
+	"\rPLAYER_JOINED\x10\x04\x12\x0f\n" +
+	"\vPLAYER_LEFT\x10\x05\x12\x0e\n" +
+	"\n" +
+	"GAME_ENDED\x10\x062\xc4\x06\n" +
+	"\vGameManager\x12K\n" +
+	"\n" +
+	"CreateGame\x12\x1d.pangram.v1.CreateGameRequest\x1a\x1e.pangram.v1.CreateGameResponse\x12K\n" +
+	"\n" +
+	"SubmitWord\x12\x1d.pangram.v1.SubmitWordRequest\x1a\x1e.pangram.v1.SubmitWordResponse\x12B\n" +
+	"\aGetGame\x12\x1a.pangram.v1.GetGameRequest\x1a\x1b.pangram.v1.GetGameResponse\x12K\n" +
 	"\n" +
-	"SubmitWord\x12\x1d.pangram.v1.SubmitWordRequest\x1a\x1e.pangram.v1.SubmitWordResponseB8Z6github.com/luispellizzon/pangram/api/pangram/v1;gamepbb\x06proto3"
+	"DeleteGame\x12\x1d.pangram.v1.DeleteGameRequest\x1a\x1e.pangram.v1.DeleteGameResponse\x12T\n" +
+	"\rListGameKinds\x12 .pangram.v1.ListGameKindsRequest\x1a!.pangram.v1.ListGameKindsResponse\x12E\n" +
+	"\bJoinGame\x12\x1b.pangram.v1.JoinGameRequest\x1a\x1c.pangram.v1.JoinGameResponse\x12H\n" +
+	"\tLeaveGame\x12\x1c.pangram.v1.LeaveGameRequest\x1a\x1d.pangram.v1.LeaveGameResponse\x12B\n" +
+	"\tWatchGame\x12\x1c.pangram.v1.WatchGameRequest\x1a\x15.pangram.v1.GameEvent0\x01\x12E\n" +
+	"\bGetHints\x12\x1b.pangram.v1.GetHintsRequest\x1a\x1c.pangram.v1.GetHintsResponse\x12T\n" +
+	"\rRevealAnswers\x12 .pangram.v1.RevealAnswersRequest\x1a!.pangram.v1.RevealAnswersResponse\x12B\n" +
+	"\aEndGame\x12\x1a.pangram.v1.EndGameRequest\x1a\x1b.pangram.v1.EndGameResponseB8Z6github.com/luispellizzon/pangram/api/pangram/v1;gamepbb\x06proto3"
 
 var (
 	file_pangram_v1_game_proto_rawDescOnce sync.Once
@@ -372,26 +2502,88 @@ func file_pangram_v1_game_proto_rawDescGZIP() []byte {
 	return file_pangram_v1_game_proto_rawDescData
 }
 
-var file_pangram_v1_game_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_pangram_v1_game_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_pangram_v1_game_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_pangram_v1_game_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_pangram_v1_game_proto_goTypes = []any{
-	(WordResult)(0),            // 0: pangram.v1.WordResult
-	(*CreateGameRequest)(nil),  // 1: pangram.v1.CreateGameRequest
-	(*CreateGameResponse)(nil), // 2: pangram.v1.CreateGameResponse
-	(*SubmitWordRequest)(nil),  // 3: pangram.v1.SubmitWordRequest
-	(*SubmitWordResponse)(nil), // 4: pangram.v1.SubmitWordResponse
+	(WordResult)(0),               // 0: pangram.v1.WordResult
+	(EventKind)(0),                // 1: pangram.v1.EventKind
+	(*CreateGameRequest)(nil),     // 2: pangram.v1.CreateGameRequest
+	(*GameOptions)(nil),           // 3: pangram.v1.GameOptions
+	(*CreateGameResponse)(nil),    // 4: pangram.v1.CreateGameResponse
+	(*SubmitWordRequest)(nil),     // 5: pangram.v1.SubmitWordRequest
+	(*SubmitWordResponse)(nil),    // 6: pangram.v1.SubmitWordResponse
+	(*GetGameRequest)(nil),        // 7: pangram.v1.GetGameRequest
+	(*GetGameResponse)(nil),       // 8: pangram.v1.GetGameResponse
+	(*Player)(nil),                // 9: pangram.v1.Player
+	(*JoinGameRequest)(nil),       // 10: pangram.v1.JoinGameRequest
+	(*JoinGameResponse)(nil),      // 11: pangram.v1.JoinGameResponse
+	(*LeaveGameRequest)(nil),      // 12: pangram.v1.LeaveGameRequest
+	(*LeaveGameResponse)(nil),     // 13: pangram.v1.LeaveGameResponse
+	(*WatchGameRequest)(nil),      // 14: pangram.v1.WatchGameRequest
+	(*GameEvent)(nil),             // 15: pangram.v1.GameEvent
+	(*GetHintsRequest)(nil),       // 16: pangram.v1.GetHintsRequest
+	(*GetHintsResponse)(nil),      // 17: pangram.v1.GetHintsResponse
+	(*HintRow)(nil),               // 18: pangram.v1.HintRow
+	(*PrefixCount)(nil),           // 19: pangram.v1.PrefixCount
+	(*RevealAnswersRequest)(nil),  // 20: pangram.v1.RevealAnswersRequest
+	(*RevealAnswersResponse)(nil), // 21: pangram.v1.RevealAnswersResponse
+	(*Answer)(nil),                // 22: pangram.v1.Answer
+	(*DeleteGameRequest)(nil),     // 23: pangram.v1.DeleteGameRequest
+	(*DeleteGameResponse)(nil),    // 24: pangram.v1.DeleteGameResponse
+	(*EndGameRequest)(nil),        // 25: pangram.v1.EndGameRequest
+	(*EndGameResponse)(nil),       // 26: pangram.v1.EndGameResponse
+	(*ListGameKindsRequest)(nil),  // 27: pangram.v1.ListGameKindsRequest
+	(*ListGameKindsResponse)(nil), // 28: pangram.v1.ListGameKindsResponse
+	(*GameKind)(nil),              // 29: pangram.v1.GameKind
+	(*GameOption)(nil),            // 30: pangram.v1.GameOption
+	nil,                           // 31: pangram.v1.HintRow.ByLengthEntry
+	(*timestamppb.Timestamp)(nil), // 32: google.protobuf.Timestamp
 }
 var file_pangram_v1_game_proto_depIdxs = []int32{
-	0, // 0: pangram.v1.SubmitWordResponse.reason:type_name -> pangram.v1.WordResult
-	1, // 1: pangram.v1.GameManager.CreateGame:input_type -> pangram.v1.CreateGameRequest
-	3, // 2: pangram.v1.GameManager.SubmitWord:input_type -> pangram.v1.SubmitWordRequest
-	2, // 3: pangram.v1.GameManager.CreateGame:output_type -> pangram.v1.CreateGameResponse
-	4, // 4: pangram.v1.GameManager.SubmitWord:output_type -> pangram.v1.SubmitWordResponse
-	3, // [3:5] is the sub-list for method output_type
-	1, // [1:3] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	3,  // 0: pangram.v1.CreateGameRequest.options:type_name -> pangram.v1.GameOptions
+	3,  // 1: pangram.v1.CreateGameResponse.options:type_name -> pangram.v1.GameOptions
+	32, // 2: pangram.v1.CreateGameResponse.ends_at:type_name -> google.protobuf.Timestamp
+	0,  // 3: pangram.v1.SubmitWordResponse.reason:type_name -> pangram.v1.WordResult
+	32, // 4: pangram.v1.GetGameResponse.created_at:type_name -> google.protobuf.Timestamp
+	3,  // 5: pangram.v1.GetGameResponse.options:type_name -> pangram.v1.GameOptions
+	9,  // 6: pangram.v1.GetGameResponse.players:type_name -> pangram.v1.Player
+	32, // 7: pangram.v1.GetGameResponse.ends_at:type_name -> google.protobuf.Timestamp
+	1,  // 8: pangram.v1.GameEvent.kind:type_name -> pangram.v1.EventKind
+	32, // 9: pangram.v1.GameEvent.at:type_name -> google.protobuf.Timestamp
+	18, // 10: pangram.v1.GetHintsResponse.rows:type_name -> pangram.v1.HintRow
+	19, // 11: pangram.v1.GetHintsResponse.prefixes:type_name -> pangram.v1.PrefixCount
+	31, // 12: pangram.v1.HintRow.by_length:type_name -> pangram.v1.HintRow.ByLengthEntry
+	22, // 13: pangram.v1.RevealAnswersResponse.found:type_name -> pangram.v1.Answer
+	22, // 14: pangram.v1.RevealAnswersResponse.missed:type_name -> pangram.v1.Answer
+	29, // 15: pangram.v1.ListGameKindsResponse.kinds:type_name -> pangram.v1.GameKind
+	30, // 16: pangram.v1.GameKind.options:type_name -> pangram.v1.GameOption
+	2,  // 17: pangram.v1.GameManager.CreateGame:input_type -> pangram.v1.CreateGameRequest
+	5,  // 18: pangram.v1.GameManager.SubmitWord:input_type -> pangram.v1.SubmitWordRequest
+	7,  // 19: pangram.v1.GameManager.GetGame:input_type -> pangram.v1.GetGameRequest
+	23, // 20: pangram.v1.GameManager.DeleteGame:input_type -> pangram.v1.DeleteGameRequest
+	27, // 21: pangram.v1.GameManager.ListGameKinds:input_type -> pangram.v1.ListGameKindsRequest
+	10, // 22: pangram.v1.GameManager.JoinGame:input_type -> pangram.v1.JoinGameRequest
+	12, // 23: pangram.v1.GameManager.LeaveGame:input_type -> pangram.v1.LeaveGameRequest
+	14, // 24: pangram.v1.GameManager.WatchGame:input_type -> pangram.v1.WatchGameRequest
+	16, // 25: pangram.v1.GameManager.GetHints:input_type -> pangram.v1.GetHintsRequest
+	20, // 26: pangram.v1.GameManager.RevealAnswers:input_type -> pangram.v1.RevealAnswersRequest
+	25, // 27: pangram.v1.GameManager.EndGame:input_type -> pangram.v1.EndGameRequest
+	4,  // 28: pangram.v1.GameManager.CreateGame:output_type -> pangram.v1.CreateGameResponse
+	6,  // 29: pangram.v1.GameManager.SubmitWord:output_type -> pangram.v1.SubmitWordResponse
+	8,  // 30: pangram.v1.GameManager.GetGame:output_type -> pangram.v1.GetGameResponse
+	24, // 31: pangram.v1.GameManager.DeleteGame:output_type -> pangram.v1.DeleteGameResponse
+	28, // 32: pangram.v1.GameManager.ListGameKinds:output_type -> pangram.v1.ListGameKindsResponse
+	11, // 33: pangram.v1.GameManager.JoinGame:output_type -> pangram.v1.JoinGameResponse
+	13, // 34: pangram.v1.GameManager.LeaveGame:output_type -> pangram.v1.LeaveGameResponse
+	15, // 35: pangram.v1.GameManager.WatchGame:output_type -> pangram.v1.GameEvent
+	17, // 36: pangram.v1.GameManager.GetHints:output_type -> pangram.v1.GetHintsResponse
+	21, // 37: pangram.v1.GameManager.RevealAnswers:output_type -> pangram.v1.RevealAnswersResponse
+	26, // 38: pangram.v1.GameManager.EndGame:output_type -> pangram.v1.EndGameResponse
+	28, // [28:39] is the sub-list for method output_type
+	17, // [17:28] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_pangram_v1_game_proto_init() }
@@ -404,8 +2596,8 @@ func file_pangram_v1_game_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pangram_v1_game_proto_rawDesc), len(file_pangram_v1_game_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   4,
+			NumEnums:      2,
+			NumMessages:   30,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,8 +19,17 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	GameManager_CreateGame_FullMethodName = "/pangram.v1.GameManager/CreateGame"
-	GameManager_SubmitWord_FullMethodName = "/pangram.v1.GameManager/SubmitWord"
+	GameManager_CreateGame_FullMethodName    = "/pangram.v1.GameManager/CreateGame"
+	GameManager_SubmitWord_FullMethodName    = "/pangram.v1.GameManager/SubmitWord"
+	GameManager_GetGame_FullMethodName       = "/pangram.v1.GameManager/GetGame"
+	GameManager_DeleteGame_FullMethodName    = "/pangram.v1.GameManager/DeleteGame"
+	GameManager_ListGameKinds_FullMethodName = "/pangram.v1.GameManager/ListGameKinds"
+	GameManager_JoinGame_FullMethodName      = "/pangram.v1.GameManager/JoinGame"
+	GameManager_LeaveGame_FullMethodName     = "/pangram.v1.GameManager/LeaveGame"
+	GameManager_WatchGame_FullMethodName     = "/pangram.v1.GameManager/WatchGame"
+	GameManager_GetHints_FullMethodName      = "/pangram.v1.GameManager/GetHints"
+	GameManager_RevealAnswers_FullMethodName = "/pangram.v1.GameManager/RevealAnswers"
+	GameManager_EndGame_FullMethodName       = "/pangram.v1.GameManager/EndGame"
 )
 
 // GameManagerClient is the client API for GameManager service.
@@ -29,6 +38,15 @@ const (
 type GameManagerClient interface {
 	CreateGame(ctx context.Context, in *CreateGameRequest, opts ...grpc.CallOption) (*CreateGameResponse, error)
 	SubmitWord(ctx context.Context, in *SubmitWordRequest, opts ...grpc.CallOption) (*SubmitWordResponse, error)
+	GetGame(ctx context.Context, in *GetGameRequest, opts ...grpc.CallOption) (*GetGameResponse, error)
+	DeleteGame(ctx context.Context, in *DeleteGameRequest, opts ...grpc.CallOption) (*DeleteGameResponse, error)
+	ListGameKinds(ctx context.Context, in *ListGameKindsRequest, opts ...grpc.CallOption) (*ListGameKindsResponse, error)
+	JoinGame(ctx context.Context, in *JoinGameRequest, opts ...grpc.CallOption) (*JoinGameResponse, error)
+	LeaveGame(ctx context.Context, in *LeaveGameRequest, opts ...grpc.CallOption) (*LeaveGameResponse, error)
+	WatchGame(ctx context.Context, in *WatchGameRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GameEvent], error)
+	GetHints(ctx context.Context, in *GetHintsRequest, opts ...grpc.CallOption) (*GetHintsResponse, error)
+	RevealAnswers(ctx context.Context, in *RevealAnswersRequest, opts ...grpc.CallOption) (*RevealAnswersResponse, error)
+	EndGame(ctx context.Context, in *EndGameRequest, opts ...grpc.CallOption) (*EndGameResponse, error)
 }
 
 type gameManagerClient struct {
@@ -59,12 +77,120 @@ func (c *gameManagerClient) SubmitWord(ctx context.Context, in *SubmitWordReques
 	return out, nil
 }
 
+func (c *gameManagerClient) GetGame(ctx context.Context, in *GetGameRequest, opts ...grpc.CallOption) (*GetGameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGameResponse)
+	err := c.cc.Invoke(ctx, GameManager_GetGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameManagerClient) DeleteGame(ctx context.Context, in *DeleteGameRequest, opts ...grpc.CallOption) (*DeleteGameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteGameResponse)
+	err := c.cc.Invoke(ctx, GameManager_DeleteGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameManagerClient) ListGameKinds(ctx context.Context, in *ListGameKindsRequest, opts ...grpc.CallOption) (*ListGameKindsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGameKindsResponse)
+	err := c.cc.Invoke(ctx, GameManager_ListGameKinds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameManagerClient) JoinGame(ctx context.Context, in *JoinGameRequest, opts ...grpc.CallOption) (*JoinGameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JoinGameResponse)
+	err := c.cc.Invoke(ctx, GameManager_JoinGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameManagerClient) LeaveGame(ctx context.Context, in *LeaveGameRequest, opts ...grpc.CallOption) (*LeaveGameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LeaveGameResponse)
+	err := c.cc.Invoke(ctx, GameManager_LeaveGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameManagerClient) WatchGame(ctx context.Context, in *WatchGameRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GameEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GameManager_ServiceDesc.Streams[0], GameManager_WatchGame_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchGameRequest, GameEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GameManager_WatchGameClient = grpc.ServerStreamingClient[GameEvent]
+
+func (c *gameManagerClient) GetHints(ctx context.Context, in *GetHintsRequest, opts ...grpc.CallOption) (*GetHintsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHintsResponse)
+	err := c.cc.Invoke(ctx, GameManager_GetHints_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameManagerClient) RevealAnswers(ctx context.Context, in *RevealAnswersRequest, opts ...grpc.CallOption) (*RevealAnswersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevealAnswersResponse)
+	err := c.cc.Invoke(ctx, GameManager_RevealAnswers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameManagerClient) EndGame(ctx context.Context, in *EndGameRequest, opts ...grpc.CallOption) (*EndGameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EndGameResponse)
+	err := c.cc.Invoke(ctx, GameManager_EndGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GameManagerServer is the server API for GameManager service.
 // All implementations must embed UnimplementedGameManagerServer
 // for forward compatibility.
 type GameManagerServer interface {
 	CreateGame(context.Context, *CreateGameRequest) (*CreateGameResponse, error)
 	SubmitWord(context.Context, *SubmitWordRequest) (*SubmitWordResponse, error)
+	GetGame(context.Context, *GetGameRequest) (*GetGameResponse, error)
+	DeleteGame(context.Context, *DeleteGameRequest) (*DeleteGameResponse, error)
+	ListGameKinds(context.Context, *ListGameKindsRequest) (*ListGameKindsResponse, error)
+	JoinGame(context.Context, *JoinGameRequest) (*JoinGameResponse, error)
+	LeaveGame(context.Context, *LeaveGameRequest) (*LeaveGameResponse, error)
+	WatchGame(*WatchGameRequest, grpc.ServerStreamingServer[GameEvent]) error
+	GetHints(context.Context, *GetHintsRequest) (*GetHintsResponse, error)
+	RevealAnswers(context.Context, *RevealAnswersRequest) (*RevealAnswersResponse, error)
+	EndGame(context.Context, *EndGameRequest) (*EndGameResponse, error)
 	mustEmbedUnimplementedGameManagerServer()
 }
 
@@ -81,6 +207,33 @@ func (UnimplementedGameManagerServer) CreateGame(context.Context, *CreateGameReq
 func (UnimplementedGameManagerServer) SubmitWord(context.Context, *SubmitWordRequest) (*SubmitWordResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitWord not implemented")
 }
+func (UnimplementedGameManagerServer) GetGame(context.Context, *GetGameRequest) (*GetGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGame not implemented")
+}
+func (UnimplementedGameManagerServer) DeleteGame(context.Context, *DeleteGameRequest) (*DeleteGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGame not implemented")
+}
+func (UnimplementedGameManagerServer) ListGameKinds(context.Context, *ListGameKindsRequest) (*ListGameKindsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGameKinds not implemented")
+}
+func (UnimplementedGameManagerServer) JoinGame(context.Context, *JoinGameRequest) (*JoinGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinGame not implemented")
+}
+func (UnimplementedGameManagerServer) LeaveGame(context.Context, *LeaveGameRequest) (*LeaveGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveGame not implemented")
+}
+func (UnimplementedGameManagerServer) WatchGame(*WatchGameRequest, grpc.ServerStreamingServer[GameEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchGame not implemented")
+}
+func (UnimplementedGameManagerServer) GetHints(context.Context, *GetHintsRequest) (*GetHintsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHints not implemented")
+}
+func (UnimplementedGameManagerServer) RevealAnswers(context.Context, *RevealAnswersRequest) (*RevealAnswersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealAnswers not implemented")
+}
+func (UnimplementedGameManagerServer) EndGame(context.Context, *EndGameRequest) (*EndGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EndGame not implemented")
+}
 func (UnimplementedGameManagerServer) mustEmbedUnimplementedGameManagerServer() {}
 func (UnimplementedGameManagerServer) testEmbeddedByValue()                     {}
 
@@ -138,6 +291,161 @@ func _GameManager_SubmitWord_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GameManager_GetGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameManagerServer).GetGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameManager_GetGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameManagerServer).GetGame(ctx, req.(*GetGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameManager_DeleteGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameManagerServer).DeleteGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameManager_DeleteGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameManagerServer).DeleteGame(ctx, req.(*DeleteGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameManager_ListGameKinds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGameKindsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameManagerServer).ListGameKinds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameManager_ListGameKinds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameManagerServer).ListGameKinds(ctx, req.(*ListGameKindsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameManager_JoinGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameManagerServer).JoinGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameManager_JoinGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameManagerServer).JoinGame(ctx, req.(*JoinGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameManager_LeaveGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameManagerServer).LeaveGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameManager_LeaveGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameManagerServer).LeaveGame(ctx, req.(*LeaveGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameManager_WatchGame_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchGameRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GameManagerServer).WatchGame(m, &grpc.GenericServerStream[WatchGameRequest, GameEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GameManager_WatchGameServer = grpc.ServerStreamingServer[GameEvent]
+
+func _GameManager_GetHints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHintsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameManagerServer).GetHints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameManager_GetHints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameManagerServer).GetHints(ctx, req.(*GetHintsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameManager_RevealAnswers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevealAnswersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameManagerServer).RevealAnswers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameManager_RevealAnswers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameManagerServer).RevealAnswers(ctx, req.(*RevealAnswersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameManager_EndGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameManagerServer).EndGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameManager_EndGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameManagerServer).EndGame(ctx, req.(*EndGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // GameManager_ServiceDesc is the grpc.ServiceDesc for GameManager service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -153,7 +461,45 @@ var GameManager_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitWord",
 			Handler:    _GameManager_SubmitWord_Handler,
 		},
+		{
+			MethodName: "GetGame",
+			Handler:    _GameManager_GetGame_Handler,
+		},
+		{
+			MethodName: "DeleteGame",
+			Handler:    _GameManager_DeleteGame_Handler,
+		},
+		{
+			MethodName: "ListGameKinds",
+			Handler:    _GameManager_ListGameKinds_Handler,
+		},
+		{
+			MethodName: "JoinGame",
+			Handler:    _GameManager_JoinGame_Handler,
+		},
+		{
+			MethodName: "LeaveGame",
+			Handler:    _GameManager_LeaveGame_Handler,
+		},
+		{
+			MethodName: "GetHints",
+			Handler:    _GameManager_GetHints_Handler,
+		},
+		{
+			MethodName: "RevealAnswers",
+			Handler:    _GameManager_RevealAnswers_Handler,
+		},
+		{
+			MethodName: "EndGame",
+			Handler:    _GameManager_EndGame_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchGame",
+			Handler:       _GameManager_WatchGame_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "pangram/v1/game.proto",
 }
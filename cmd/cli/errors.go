@@ -0,0 +1,85 @@
+package main
+
+import (
+	"fmt"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Turn a gRPC error into a message a player can act on, using the status code and the details sent by the server
+func describe(err error) string {
+	st, ok := status.FromError(err)
+	if !ok { return fmt.Sprintf("Response error: %v", err) }
+	switch st.Code() {
+	case codes.NotFound:
+		for _, detail := range st.Details() {
+			if info, ok := detail.(*errdetails.ResourceInfo); ok {
+				return fmt.Sprintf("Game %s was not found. Check the --game_id or start a new game.", info.GetResourceName())
+			}
+		}
+		return "Game not found. Check the --game_id or start a new game."
+	case codes.InvalidArgument:
+		for _, detail := range st.Details() {
+			if bad, ok := detail.(*errdetails.BadRequest); ok && len(bad.GetFieldViolations()) > 0 {
+				violation := bad.GetFieldViolations()[0]
+				return fmt.Sprintf("Invalid %s: %s", violation.GetField(), violation.GetDescription())
+			}
+		}
+		return fmt.Sprintf("Invalid request: %s", st.Message())
+	case codes.Unimplemented:
+		return fmt.Sprintf("This game mode is not available yet (%s).", st.Message())
+	case codes.FailedPrecondition:
+		for _, detail := range st.Details() {
+			if info, ok := detail.(*errdetails.ErrorInfo); ok && info.GetReason() == "OWNER_CAN_NOT_LEAVE" { return "You created this game, so you can not leave it. Use /delete to end it." }
+			if info, ok := detail.(*errdetails.ErrorInfo); ok && info.GetReason() == "GAME_OVER" { return "This game is over, no one can join it anymore." }
+			if info, ok := detail.(*errdetails.ErrorInfo); ok && info.GetReason() == "NOT_MULTIPLAYER" { return "This game has no named players, it can not be joined." }
+			if info, ok := detail.(*errdetails.ErrorInfo); ok && info.GetReason() == "ANSWERS_HIDDEN" { return "The answers are shown once the game ends, or once the daily board it was started on has rotated. Single player and co-op games end with /giveup." }
+			if info, ok := detail.(*errdetails.ErrorInfo); ok && info.GetReason() == "GAME_ENDS_ON_ITS_OWN" { return "This game ends on its own, it can not be given up." }
+		}
+		return fmt.Sprintf("The server can not start this game right now: %s", st.Message())
+	case codes.Unauthenticated:
+		return "This game needs its token, rejoin with --game_id <GAME_ID> --token <TOKEN>."
+	case codes.PermissionDenied:
+		for _, detail := range st.Details() {
+			if info, ok := detail.(*errdetails.ErrorInfo); ok && info.GetReason() == "READ_ONLY" {
+				return "You are watching this game with a share token, only the player can submit words."
+			}
+			if info, ok := detail.(*errdetails.ErrorInfo); ok && info.GetReason() == "NOT_OWNER" {
+				return "Only the player that created the game can do this."
+			}
+			if info, ok := detail.(*errdetails.ErrorInfo); ok && info.GetReason() == "NOT_INVITE" {
+				return "This token can only watch the game, ask the player that created it for the invite token."
+			}
+		}
+		return "This token does not belong to the game."
+	case codes.AlreadyExists:
+		return "Another player already has this name, join with a different --name."
+	case codes.ResourceExhausted:
+		for _, detail := range st.Details() {
+			if info, ok := detail.(*errdetails.ErrorInfo); ok && info.GetReason() == "GAME_FULL" {
+				return "This game is full, no more players can join."
+			}
+			if info, ok := detail.(*errdetails.ErrorInfo); ok && info.GetReason() == "WATCHER_TOO_SLOW" {
+				return "Live updates stopped because they fell behind, use /players to see the game."
+			}
+		}
+		return "The server is full right now, please try again later."
+	case codes.Unavailable:
+		return "The server is unavailable right now, please try again."
+	case codes.DeadlineExceeded:
+		return "The server took too long to answer, please try again."
+	default:
+		return fmt.Sprintf("Response error: %s", st.Message())
+	}
+}
+
+// Errors the player can not recover from by trying again
+func fatal(err error) bool {
+	switch status.Code(err) {
+	case codes.NotFound, codes.AlreadyExists, codes.InvalidArgument, codes.Unimplemented, codes.FailedPrecondition, codes.Unauthenticated, codes.PermissionDenied:
+		return true
+	}
+	return false
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 	"time"
@@ -17,62 +18,217 @@ import (
 
 func main() {
 	gameID := flag.String("game_id", "", "--game_id flag to rejoin game, or create new game if not specified in the terminal")
-	gameMode := flag.String("mode", "", "--mode flag for singleplayer OR multiplayer")
+	gameMode := flag.String("mode", "", "--mode flag with the game kind, e.g. singleplayer (the server lists the kinds it offers)")
+	token := flag.String("token", "", "--token player token (or share token to watch) of the game given with --game_id")
+	share := flag.Bool("share", false, "--share also create a read-only token so others can watch the new game")
+	minLength := flag.Int("min_length", 0, "--min_length shortest word accepted in the new game (server default when 0)")
+	scoring := flag.String("scoring", "", "--scoring basic or bonus scoring for the new game (server default when empty)")
+	bonus := flag.Int("bonus", 0, "--bonus extra points for a pangram with bonus scoring (server default when 0)")
+	timeLimit := flag.Duration("time_limit", 0, "--time_limit time to play the new game, for the modes that have one")
+	board := flag.String("board", "", "--board daily, random or a past date YYYY-MM-DD (daily when empty)")
+	date := flag.String("date", "", "--date play the puzzle of a past date YYYY-MM-DD, same as --board with a date")
+	puzzle := flag.Int("puzzle", 0, "--puzzle play a past puzzle by its number, 1 is the first puzzle")
+	target := flag.Int("target", 0, "--target score that wins a race")
+	firstBonus := flag.Int("first_bonus", 0, "--first_bonus extra points in a race for the first player to find a word")
+	lives := flag.Int("lives", 0, "--lives misses a hardcore game allows (server default when 0)")
+	missPenalty := flag.Int("miss_penalty", 0, "--miss_penalty points a hardcore game takes for every miss")
+	name := flag.String("name", "", "--name your player name in multiplayer games")
+	join := flag.Bool("join", false, "--join join the multiplayer game given with --game_id, using its invite token as --token")
 	flag.Parse()
+	if *date != "" { *board = *date }
 	conn, err := grpc.NewClient("localhost:50051", grpc.WithTransportCredentials(insecure.NewCredentials()))
 	if err != nil { logger.Log().Errorf("SERVER %v", err) }
 	defer conn.Close()
 
 	client := gamepb.NewGameManagerClient(conn)
+	cli := bufio.NewScanner(os.Stdin)
 
 	var id string
+	named := false
+	me := ""
+	var endsAt time.Time
 	// create new game
 	if *gameID == "" {
+		// the modes come from the server, so new game kinds show up without changing the client
+		kinds, err := listKinds(client)
+		if err != nil {
+			fmt.Println(describe(err))
+			os.Exit(1)
+		}
 		if *gameMode == "" {
-			// prompt for mode singleplayer vs multiplayer
-			*gameMode = getMode()
+			// prompt for one of the modes offered by the server
+			*gameMode = getMode(cli, kinds)
 		}
+		named = hasPlayers(*gameMode, kinds)
+		if named && *name == "" { *name = getName(cli) }
+		if named { me = strings.TrimSpace(*name) }
 
 		// check if mode chosen is valid
-		if !isValidMode(*gameMode) {
-			fmt.Printf("Invalid mode: %q. Use 'singleplayer' or 'multiplayer'.\n", *gameMode)
+		if !isValidMode(*gameMode, kinds) {
+			fmt.Printf("Invalid mode: %q. Use one of: %s.\n", *gameMode, strings.Join(modeNames(kinds), ", "))
 			os.Exit(2)
 		}
 		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 		defer cancel()
 
 		// Create new game
-		response, err := client.CreateGame(ctx, &gamepb.CreateGameRequest{Kind: *gameMode})
-		if err != nil { panic(err) }
+		options := &gamepb.GameOptions{
+			MinLength: int32(*minLength), Scoring: *scoring, PangramBonus: int32(*bonus),
+			TimeLimitSeconds: int32(timeLimit.Seconds()), Board: *board,
+			TargetScore: int32(*target), FirstBonus: int32(*firstBonus),
+			Lives: int32(*lives), MissPenalty: int32(*missPenalty), Puzzle: int32(*puzzle),
+		}
+		response, err := client.CreateGame(ctx, &gamepb.CreateGameRequest{Kind: *gameMode, Share: *share, Options: options, Player: *name})
+		if err != nil {
+			fmt.Println(describe(err))
+			os.Exit(1)
+		}
 
 		// Get new game id and game info about the pangram
 		id = response.GetId()
 		fmt.Printf("Game ID: %s %s \nletters: %s \ncenter: %s\n",
 			response.GetId(), response.GetName(), strings.Join(response.GetLetters(), " "), response.GetCenter())
+		if response.GetMaxScore() > 0 {
+			fmt.Printf("words: %d \npangrams: %d \nmax points: %d\n", response.GetAnswers(), response.GetPangrams(), response.GetMaxScore())
+		}
+		fmt.Printf("rules: %s\n", rules(response.GetOptions()))
+		if response.GetEndsAt() != nil { endsAt = response.GetEndsAt().AsTime() }
+		*token = response.GetPlayerToken()
+		fmt.Printf("player token: %s (keep it secret, rejoin with --game_id %s --token %s)\n", *token, id, *token)
+		if response.GetInviteToken() != "" {
+			fmt.Printf("invite token: %s (friends join with --game_id %s --token %s --join --name <NAME>)\n", response.GetInviteToken(), id, response.GetInviteToken())
+		}
+		if response.GetShareToken() != "" {
+			fmt.Printf("share token: %s (others can watch with --game_id %s --token %s)\n", response.GetShareToken(), id, response.GetShareToken())
+		}
 	} else {
-		// rejoin previous game using game id
+		// rejoin previous game using game id and token, and print where the game was left
 		id = *gameID
+		if *token == "" {
+			fmt.Println("Rejoining a game needs its token: --game_id <GAME_ID> --token <TOKEN>")
+			os.Exit(2)
+		}
+		if *join {
+			// joining gives this player a token of its own, the invite token is not used again
+			if *name == "" { *name = getName(cli) }
+			ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+			joined, err := client.JoinGame(ctx, &gamepb.JoinGameRequest{Id: id, Token: *token, Player: *name})
+			cancel()
+			if err != nil {
+				fmt.Println(describe(err))
+				os.Exit(1)
+			}
+			*token = joined.GetPlayerToken()
+			fmt.Printf("Joined as %s. \nplayer token: %s (keep it secret, rejoin with --game_id %s --token %s)\n", joined.GetPlayer(), *token, id, *token)
+		}
 		fmt.Printf("Joining existing game -> %s.\n", id)
+		state, err := getGame(client, id, *token)
+		if err != nil {
+			fmt.Println(describe(err))
+			os.Exit(1)
+		}
+		fmt.Printf("%s \nletters: %s \ncenter: %s \nstarted: %s\n",
+			state.GetName(), strings.Join(state.GetLetters(), " "), state.GetCenter(), state.GetCreatedAt().AsTime().Local().Format(time.DateTime))
+		fmt.Printf("rules: %s\n", rules(state.GetOptions()))
+		printState(state)
+		named = state.GetPlayer() != ""
+		me = state.GetPlayer()
+		if state.GetEndsAt() != nil { endsAt = state.GetEndsAt().AsTime() }
+
+		// the share token can only watch the game
+		if state.GetReadOnly() {
+			watch(cli, client, id, *token)
+			return
+		}
 	}
 
 	// game loop
-	cli := bufio.NewScanner(os.Stdin)
+	prompt := "Enter word (or /hints, /answers, /giveup, /quit, /delete): "
+	if named {
+		prompt = "Enter word (or /players, /hints, /answers, /giveup, /leave, /quit, /delete): "
+		// show what the other players do while this one plays
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go follow(ctx, client, id, *token, me, true)
+	}
+
+	// read words in the background, so the loop can stop when the time of the game runs out
+	lines := make(chan string)
+	go func() {
+		for cli.Scan() { lines <- cli.Text() }
+		close(lines)
+	}()
+	var timeUp <-chan time.Time
+	if !endsAt.IsZero() { timeUp = time.After(time.Until(endsAt)) }
 	for {
-		fmt.Print("Enter word (or /quit): ")
-		if !cli.Scan() {
-			break
+		fmt.Print(timeLeft(endsAt) + prompt)
+		var line string
+		ok := false
+		select {
+		case line, ok = <-lines:
+		case <-timeUp:
+			fmt.Println("\nTIME UP!")
+			if state, err := getGame(client, id, *token); err == nil { printState(state) }
+			showAnswers(client, id, *token)
 		}
-		w := strings.TrimSpace(cli.Text())
+		if !ok { break }
+		w := strings.TrimSpace(line)
 		if w == "" { continue }
 		if w == "/quit" { break }
+		if w == "/players" {
+			state, err := getGame(client, id, *token)
+			if err != nil { fmt.Println(describe(err)); continue }
+			printState(state)
+			continue
+		}
+		if w == "/hints" {
+			ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+			hints, err := client.GetHints(ctx, &gamepb.GetHintsRequest{Id: id, Token: *token})
+			cancel()
+			if err != nil { fmt.Println(describe(err)); continue }
+			printHints(hints)
+			continue
+		}
+		if w == "/answers" {
+			showAnswers(client, id, *token)
+			continue
+		}
+		if w == "/giveup" {
+			// end the game without deleting it, so every answer can be seen
+			ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+			_, err := client.EndGame(ctx, &gamepb.EndGameRequest{Id: id, Token: *token})
+			cancel()
+			if err != nil { fmt.Println(describe(err)); continue }
+			fmt.Println("GAME OVER! You gave up.")
+			showAnswers(client, id, *token)
+			break
+		}
+		if w == "/leave" {
+			ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+			_, err := client.LeaveGame(ctx, &gamepb.LeaveGameRequest{Id: id, Token: *token})
+			cancel()
+			if err != nil { fmt.Println(describe(err)); continue }
+			fmt.Printf("You left game %s, your words and points stay in it.\n", id)
+			break
+		}
+		if w == "/delete" {
+			// end the game for good, the server frees it
+			ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+			_, err := client.DeleteGame(ctx, &gamepb.DeleteGameRequest{Id: id, Token: *token})
+			cancel()
+			if err != nil { fmt.Println(describe(err)); continue }
+			fmt.Printf("Game %s deleted.\n", id)
+			break
+		}
 
 		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 
 		// Submit word to game by id to be checked on the server
-		resp, err := client.SubmitWord(ctx, &gamepb.SubmitWordRequest{Id: id, Word: w})
+		resp, err := client.SubmitWord(ctx, &gamepb.SubmitWordRequest{Id: id, Word: w, Token: *token})
 		cancel()
 		if err != nil {
-			fmt.Printf("Response error: %v\n", err)
+			fmt.Println(describe(err))
+			if fatal(err) { os.Exit(1) }
 			continue
 		}
 
@@ -80,36 +236,260 @@ func main() {
 		// Check if word is valid
 		if resp.GetValid() {
 			// Check if the word is the pangram
-			if resp.GetPangram(){
-				fmt.Printf("IS PANGRAM: +%d \nTOTAL POINTS: %d\n",
+			if resp.GetPerfect() {
+				fmt.Printf("PERFECT PANGRAM: +%d \nTOTAL POINTS: %s\n",
+				resp.GetPoints(), progress(resp))
+			} else if resp.GetPangram(){
+				fmt.Printf("IS PANGRAM: +%d \nTOTAL POINTS: %s\n",
 				// Show points
-				resp.GetPoints(), resp.GetTotal())
+				resp.GetPoints(), progress(resp))
 			} else {
-				fmt.Printf("VALID: +%d \nTOTAL POINTS: %d\n",
-				resp.GetPoints(), resp.GetTotal())
+				fmt.Printf("VALID: +%d \nTOTAL POINTS: %s\n",
+				resp.GetPoints(), progress(resp))
+			}
+			if resp.GetFirstBonus() > 0 { fmt.Printf("FIRST TO FIND IT: +%d\n", resp.GetFirstBonus()) }
+			if resp.GetPlayer() != "" { fmt.Printf("YOUR POINTS: %d\n", resp.GetPlayerPoints()) }
+			if resp.GetBoardPangram() { fmt.Printf("%s IS THE BOARD PANGRAM!\n", strings.ToUpper(resp.GetWord())) }
+			if resp.GetRankUp() { fmt.Printf("RANK UP: %s!\n", resp.GetRank()) }
+			if resp.GetComplete() { fmt.Println("YOU FOUND EVERY WORD!") }
+			if resp.GetNextRank() != "" {
+				fmt.Printf("RANK: %s (%d points to %s)\n", resp.GetRank(), resp.GetPointsToNext(), resp.GetNextRank())
 			}
 		} else {
-			fmt.Printf("INVALID: %s \nTOTAL POINTS: %d)\n", resp.GetReason().String(), resp.GetTotal())
+			fmt.Printf("INVALID: %s \nTOTAL POINTS: %s\n", resp.GetReason().String(), progress(resp))
+			if resp.GetPenalty() > 0 { fmt.Printf("PENALTY: -%d\n", resp.GetPenalty()) }
+		}
+		if resp.GetLives() > 0 { fmt.Printf("LIVES: %d\n", resp.GetLives()) }
+		if resp.GetGameOver() {
+			fmt.Println(gameOver(resp.GetWinner(), named))
+			showAnswers(client, id, *token)
+			break
 		}
 	}
 }
 
 
-func getMode() string {
-	reader := bufio.NewScanner(os.Stdin)
+func getGame(client gamepb.GameManagerClient, id string, token string) (*gamepb.GetGameResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	return client.GetGame(ctx, &gamepb.GetGameRequest{Id: id, Token: token})
+}
+
+// Print found words, points and rank of a game
+func printState(state *gamepb.GetGameResponse) {
+	fmt.Printf("found (%d): %s\n", len(state.GetFound()), strings.Join(state.GetFound(), ", "))
+	if state.GetMaxScore() > 0 {
+		fmt.Printf("TOTAL POINTS: %d/%d \nRANK: %s\n", state.GetTotal(), state.GetMaxScore(), state.GetRank())
+	} else {
+		fmt.Printf("TOTAL POINTS: %d\n", state.GetTotal())
+	}
+	if state.GetLives() > 0 { fmt.Printf("LIVES: %d\n", state.GetLives()) }
+	if state.GetEndsAt() != nil && !state.GetEnded() {
+		fmt.Printf("ENDS IN: %s\n", time.Until(state.GetEndsAt().AsTime()).Round(time.Second))
+	}
+	for _, player := range state.GetPlayers() {
+		you := ""
+		if player.GetName() == state.GetPlayer() { you = " (you)" }
+		if player.GetLeft() { you += " (left)" }
+		fmt.Printf("  %s%s: %d points, %d words\n", player.GetName(), you, player.GetPoints(), player.GetWords())
+	}
+	if state.GetEnded() { fmt.Println(gameOver(state.GetWinner(), len(state.GetPlayers()) > 0)) }
+}
+
+// Print the hint grid: words left by first letter and length, then by their first two letters
+func printHints(hints *gamepb.GetHintsResponse) {
+	fmt.Printf("WORDS LEFT: %d, PANGRAMS LEFT: %d\n", hints.GetWords(), hints.GetPangrams())
+	if hints.GetWords() == 0 { return }
+	fmt.Print("    ")
+	for _, n := range hints.GetLengths() { fmt.Printf("%4d", n) }
+	fmt.Printf("%5s\n", "Σ")
+	totals := map[int32]int32{}
+	for _, row := range hints.GetRows() {
+		fmt.Printf("%-4s", strings.ToUpper(row.GetLetter())+":")
+		for _, n := range hints.GetLengths() {
+			count := row.GetByLength()[n]
+			totals[n] += count
+			if count == 0 { fmt.Printf("%4s", "-") } else { fmt.Printf("%4d", count) }
+		}
+		fmt.Printf("%5d\n", row.GetTotal())
+	}
+	fmt.Printf("%-4s", "Σ:")
+	for _, n := range hints.GetLengths() { fmt.Printf("%4d", totals[n]) }
+	fmt.Printf("%5d\n", hints.GetWords())
+	prefixes := make([]string, 0, len(hints.GetPrefixes()))
+	for _, prefix := range hints.GetPrefixes() { prefixes = append(prefixes, fmt.Sprintf("%s-%d", strings.ToUpper(prefix.GetPrefix()), prefix.GetCount())) }
+	fmt.Printf("TWO LETTER LIST: %s\n", strings.Join(prefixes, " "))
+}
+
+// Print the words found and missed as a table, pangrams in capitals with a star
+func showAnswers(client gamepb.GameManagerClient, id string, token string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	answers, err := client.RevealAnswers(ctx, &gamepb.RevealAnswersRequest{Id: id, Token: token})
+	if err != nil { fmt.Println(describe(err)); return }
+	row := func(answer *gamepb.Answer) {
+		word := answer.GetWord()
+		if answer.GetPangram() { word = strings.ToUpper(word) + " *" }
+		fmt.Printf("  %-20s %6d\n", word, answer.GetPoints())
+	}
+	fmt.Printf("  %-20s %6s\n", "WORD", "POINTS")
+	fmt.Printf("FOUND: %d words, %d points\n", len(answers.GetFound()), answers.GetFoundPoints())
+	for _, answer := range answers.GetFound() { row(answer) }
+	fmt.Printf("MISSED: %d words, %d points\n", len(answers.GetMissed()), answers.GetMissedPoints())
+	for _, answer := range answers.GetMissed() { row(answer) }
+	fmt.Println("* pangram")
+}
+
+// Only games with named players have a winner
+func gameOver(winner string, named bool) string {
+	if !named { return "GAME OVER! YOUR SCORE IS FINAL." }
+	if winner == "" { return "GAME OVER! IT IS A DRAW." }
+	return fmt.Sprintf("GAME OVER! WINNER: %s", winner)
+}
+
+// Time left to play, shown before the prompt of timed games
+func timeLeft(endsAt time.Time) string {
+	if endsAt.IsZero() { return "" }
+	return fmt.Sprintf("[%s left] ", max(time.Until(endsAt), 0).Round(time.Second))
+}
+
+// Spectator loop, the share token can read the game but not play it. Events are printed as they happen
+func watch(cli *bufio.Scanner, client gamepb.GameManagerClient, id string, token string) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	state, err := getGame(client, id, token)
+	go follow(ctx, client, id, token, "", err == nil && len(state.GetPlayers()) > 0)
+	for {
+		fmt.Print("Watching live, press Enter to refresh (or /quit): ")
+		if !cli.Scan() || strings.TrimSpace(cli.Text()) == "/quit" { return }
+		state, err := getGame(client, id, token)
+		if err != nil {
+			fmt.Println(describe(err))
+			if fatal(err) { return }
+			continue
+		}
+		printState(state)
+	}
+}
+
+// Print the game events streamed by the server until ctx is cancelled. The words of this player are already printed by the game loop
+func follow(ctx context.Context, client gamepb.GameManagerClient, id string, token string, me string, named bool) {
+	stream, err := client.WatchGame(ctx, &gamepb.WatchGameRequest{Id: id, Token: token})
+	if err != nil { return }
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			if ctx.Err() == nil && err != io.EOF { fmt.Printf("\n%s\n", describe(err)) }
+			return
+		}
+		if line := describeEvent(event, me, named); line != "" { fmt.Printf("\n%s\n", line) }
+	}
+}
+
+func describeEvent(event *gamepb.GameEvent, me string, named bool) string {
+	if me != "" && event.GetPlayer() == me { return "" }
+	who := event.GetPlayer()
+	if who == "" { who = "player" }
+	switch event.GetKind() {
+	case gamepb.EventKind_WORD_FOUND:
+		if event.GetWord() == "" { return fmt.Sprintf("* %s found a word (+%d)", who, event.GetPoints()) }
+		return fmt.Sprintf("* %s found %s (+%d)", who, strings.ToUpper(event.GetWord()), event.GetPoints())
+	case gamepb.EventKind_SCORE_CHANGED:
+		if event.GetPlayer() != "" && event.GetPlayerPoints() != event.GetTotal() {
+			return fmt.Sprintf("* %s: %d points, team: %d points", who, event.GetPlayerPoints(), event.GetTotal())
+		}
+		return fmt.Sprintf("* %s: %d points", who, event.GetTotal())
+	case gamepb.EventKind_RANK_UP:
+		return fmt.Sprintf("* %s reached %s", who, event.GetRank())
+	case gamepb.EventKind_PLAYER_JOINED:
+		return fmt.Sprintf("* %s joined the game", who)
+	case gamepb.EventKind_PLAYER_LEFT:
+		return fmt.Sprintf("* %s left the game", who)
+	case gamepb.EventKind_GAME_ENDED:
+		return gameOver(event.GetWinner(), named)
+	}
+	return ""
+}
+
+// Show total points out of the board max score when the server knows it
+func progress(resp *gamepb.SubmitWordResponse) string {
+	if resp.GetMaxScore() == 0 { return fmt.Sprint(resp.GetTotal()) }
+	return fmt.Sprintf("%d/%d", resp.GetTotal(), resp.GetMaxScore())
+}
+
+// Rules the server applied to the game
+func rules(options *gamepb.GameOptions) string {
+	parts := []string{fmt.Sprintf("words of %d+ letters", options.GetMinLength())}
+	if options.GetScoring() == "bonus" {
+		parts = append(parts, fmt.Sprintf("bonus scoring (+%d per pangram)", options.GetPangramBonus()))
+	} else if options.GetScoring() != "" {
+		parts = append(parts, options.GetScoring()+" scoring")
+	}
+	if options.GetTimeLimitSeconds() > 0 {
+		parts = append(parts, fmt.Sprintf("%s to play", time.Duration(options.GetTimeLimitSeconds())*time.Second))
+	}
+	if options.GetLives() > 0 { parts = append(parts, fmt.Sprintf("%d lives", options.GetLives())) }
+	if options.GetMissPenalty() > 0 { parts = append(parts, fmt.Sprintf("-%d per miss", options.GetMissPenalty())) }
+	if options.GetBoard() != "" { parts = append(parts, options.GetBoard()+" board") }
+	if options.GetPuzzle() > 0 { parts = append(parts, fmt.Sprintf("puzzle #%d", options.GetPuzzle())) }
+	return strings.Join(parts, ", ")
+}
+
+// Game kinds the server can create right now
+func listKinds(client gamepb.GameManagerClient) ([]*gamepb.GameKind, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	response, err := client.ListGameKinds(ctx, &gamepb.ListGameKindsRequest{})
+	if err != nil { return nil, err }
+	kinds := []*gamepb.GameKind{}
+	for _, kind := range response.GetKinds() {
+		if kind.GetAvailable() { kinds = append(kinds, kind) }
+	}
+	return kinds, nil
+}
+
+func modeNames(kinds []*gamepb.GameKind) []string {
+	names := make([]string, 0, len(kinds))
+	for _, kind := range kinds { names = append(names, kind.GetName()) }
+	return names
+}
+
+func getMode(reader *bufio.Scanner, kinds []*gamepb.GameKind) string {
+	for _, kind := range kinds { fmt.Printf("  %s: %s\n", kind.GetName(), kind.GetDescription()) }
+	names := modeNames(kinds)
 	for {
-		fmt.Print("Choose a game mode [singleplayer/multiplayer]: ")
+		fmt.Printf("Choose a game mode [%s]: ", strings.Join(names, "/"))
 		if !reader.Scan() {
-			return "singleplayer" 
+			if len(names) > 0 { return names[0] }
+			return ""
 		}
 		m := strings.ToLower(strings.TrimSpace(reader.Text()))
-		if isValidMode(m) {
+		if isValidMode(m, kinds) {
 			return m
 		}
-		fmt.Println("Please, enter 'singleplayer' or 'multiplayer'.")
+		fmt.Printf("Please, enter one of: %s.\n", strings.Join(names, ", "))
+	}
+}
+
+// Kinds with named players need a name to create or join a game
+func hasPlayers(gameMode string, kinds []*gamepb.GameKind) bool {
+	for _, kind := range kinds {
+		if kind.GetName() == gameMode { return kind.GetPlayers() }
 	}
+	return false
 }
 
-func isValidMode(gameMode string) bool {
-	return gameMode == "singleplayer" || gameMode == "multiplayer"
-}
\ No newline at end of file
+func getName(reader *bufio.Scanner) string {
+	for {
+		fmt.Print("Your player name: ")
+		if !reader.Scan() { return "" }
+		if name := strings.TrimSpace(reader.Text()); name != "" { return name }
+	}
+}
+
+func isValidMode(gameMode string, kinds []*gamepb.GameKind) bool {
+	for _, kind := range kinds {
+		if kind.GetName() == gameMode { return true }
+	}
+	return false
+}
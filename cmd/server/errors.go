@@ -0,0 +1,176 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/luispellizzon/pangram/internal/games"
+	"github.com/luispellizzon/pangram/internal/logger"
+	"github.com/luispellizzon/pangram/internal/manager"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// Domain used in the error details, so clients know the reasons come from this service
+const errorDomain = "pangram.v1"
+
+// Build a status with details. If the details can not be attached we still return the plain status
+func withDetails(code codes.Code, message string, details ...protoadapt.MessageV1) error {
+	st := status.New(code, message)
+	detailed, err := st.WithDetails(details...)
+	if err != nil {
+		logger.Log().Errorf("ERROR DETAILS: %v", err)
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// Game id that does not exist in the manager
+func gameNotFound(id string) error {
+	logger.Log().Errorf("GAME NOT FOUND: %s", id)
+	return withDetails(codes.NotFound, "game not found",
+		&errdetails.ResourceInfo{ResourceType: "game", ResourceName: id, Description: "no game with this id, create a new game"},
+		&errdetails.ErrorInfo{Reason: "GAME_NOT_FOUND", Domain: errorDomain, Metadata: map[string]string{"game_id": id}},
+	)
+}
+
+// Request field with a bad value
+func invalidField(field string, description string) error {
+	return withDetails(codes.InvalidArgument, description,
+		&errdetails.BadRequest{FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: field, Description: description}}},
+	)
+}
+
+// Dictionary failure, the client can send the same request again
+func dictUnavailable(id string) error {
+	return withDetails(codes.Unavailable, "DICT_UNAVAILABLE: dictionary lookup failed, try again",
+		&errdetails.ErrorInfo{Reason: "DICT_UNAVAILABLE", Domain: errorDomain, Metadata: map[string]string{"game_id": id}},
+		&errdetails.RetryInfo{},
+	)
+}
+
+// Map the manager errors of a game lookup, the details never say which tokens exist
+func accessError(id string, err error) error {
+	info := func(reason string) *errdetails.ErrorInfo {
+		return &errdetails.ErrorInfo{Reason: reason, Domain: errorDomain, Metadata: map[string]string{"game_id": id}}
+	}
+	switch {
+	case errors.Is(err, manager.ErrNotFound):
+		return gameNotFound(id)
+	case errors.Is(err, manager.ErrNoToken):
+		return withDetails(codes.Unauthenticated, err.Error(), info("TOKEN_REQUIRED"))
+	case errors.Is(err, manager.ErrBadToken):
+		logger.Log().Errorf("BAD TOKEN FOR GAME %s", id)
+		return withDetails(codes.PermissionDenied, err.Error(), info("TOKEN_MISMATCH"))
+	case errors.Is(err, manager.ErrReadOnly):
+		return withDetails(codes.PermissionDenied, err.Error(), info("READ_ONLY"))
+	case errors.Is(err, manager.ErrNotOwner):
+		return withDetails(codes.PermissionDenied, err.Error(), info("NOT_OWNER"))
+	case errors.Is(err, manager.ErrNotInvite):
+		return withDetails(codes.PermissionDenied, err.Error(), info("NOT_INVITE"))
+	case errors.Is(err, manager.ErrOwnerLeave):
+		return withDetails(codes.FailedPrecondition, err.Error(), info("OWNER_CAN_NOT_LEAVE"))
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+// Board a game asked for, the subject of a board that could not be built: a puzzle number, a date, random or daily
+func boardOf(options games.Options, defaults games.Options) string {
+	switch {
+	case options.Puzzle != 0: return fmt.Sprintf("puzzle %d", options.Puzzle)
+	case options.Board != "": return options.Board
+	case defaults.Board != "": return defaults.Board
+	default: return games.BoardDaily
+	}
+}
+
+// Map the factory errors to canonical codes
+func createError(kind string, board string, err error) error {
+	logger.Log().Errorf("CREATE GAME %q: %v", kind, err)
+	var option *games.OptionError
+	switch {
+	case errors.As(err, &option):
+		return invalidField("options."+option.Option, option.Reason)
+	case errors.Is(err, games.ErrBadPlayer):
+		return invalidField("player", err.Error())
+	case errors.Is(err, games.ErrUnknownKind):
+		return invalidField("kind", err.Error())
+	case errors.Is(err, games.ErrKindNotAvailable):
+		return withDetails(codes.Unimplemented, err.Error(),
+			&errdetails.ErrorInfo{Reason: "GAME_KIND_NOT_AVAILABLE", Domain: errorDomain, Metadata: map[string]string{"kind": kind}},
+		)
+	case errors.Is(err, manager.ErrTooManyGames):
+		return withDetails(codes.ResourceExhausted, err.Error(),
+			&errdetails.QuotaFailure{Violations: []*errdetails.QuotaFailure_Violation{{Subject: "games", Description: err.Error()}}},
+			&errdetails.RetryInfo{},
+		)
+	case errors.Is(err, games.ErrNoBoard):
+		return withDetails(codes.FailedPrecondition, err.Error(),
+			&errdetails.PreconditionFailure{Violations: []*errdetails.PreconditionFailure_Violation{{Type: "BOARD", Subject: board, Description: err.Error()}}},
+		)
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+// Map the errors of joining a game, token errors are the same as any other game lookup
+func joinError(id string, err error) error {
+	logger.Log().Errorf("JOIN GAME %s: %v", id, err)
+	info := &errdetails.ErrorInfo{Domain: errorDomain, Metadata: map[string]string{"game_id": id}}
+	switch {
+	case errors.Is(err, games.ErrBadPlayer):
+		return invalidField("player", err.Error())
+	case errors.Is(err, games.ErrPlayerTaken):
+		info.Reason = "PLAYER_TAKEN"
+		return withDetails(codes.AlreadyExists, err.Error(), info)
+	case errors.Is(err, games.ErrGameFull):
+		info.Reason = "GAME_FULL"
+		return withDetails(codes.ResourceExhausted, err.Error(), info)
+	case errors.Is(err, games.ErrGameOver):
+		info.Reason = "GAME_OVER"
+		return withDetails(codes.FailedPrecondition, err.Error(), info)
+	case errors.Is(err, games.ErrNoPlayer):
+		info.Reason = "NOT_IN_GAME"
+		return withDetails(codes.FailedPrecondition, err.Error(), info)
+	case errors.Is(err, games.ErrNoPlayers):
+		info.Reason = "NOT_MULTIPLAYER"
+		return withDetails(codes.FailedPrecondition, err.Error(), info)
+	default:
+		return accessError(id, err)
+	}
+}
+
+// Games with an end of their own can not be given up
+func endError(id string, err error) error {
+	if !errors.Is(err, games.ErrNoEnd) { return accessError(id, err) }
+	return withDetails(codes.FailedPrecondition, err.Error(),
+		&errdetails.ErrorInfo{Reason: "GAME_ENDS_ON_ITS_OWN", Domain: errorDomain, Metadata: map[string]string{"game_id": id}},
+	)
+}
+
+// Answers are refused while the board is still played
+func revealError(id string, err error) error {
+	if !errors.Is(err, games.ErrAnswersHidden) { return accessError(id, err) }
+	return withDetails(codes.FailedPrecondition, err.Error(),
+		&errdetails.ErrorInfo{Reason: "ANSWERS_HIDDEN", Domain: errorDomain, Metadata: map[string]string{"game_id": id}},
+	)
+}
+
+// Why a watch stream ended on the server side
+func watchError(id string, err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, manager.ErrSlowWatcher):
+		logger.Log().Errorf("SLOW WATCHER DROPPED - ID: %s", id)
+		return withDetails(codes.ResourceExhausted, err.Error(),
+			&errdetails.ErrorInfo{Reason: "WATCHER_TOO_SLOW", Domain: errorDomain, Metadata: map[string]string{"game_id": id}},
+			&errdetails.RetryInfo{},
+		)
+	default:
+		return accessError(id, err)
+	}
+}
@@ -2,9 +2,11 @@ package main
 
 import (
 	"context"
-	"fmt"
+	"errors"
+	"flag"
 	"log"
 	"net"
+	"time"
 
 	gamepb "github.com/luispellizzon/pangram/api/pangram/v1"
 	"github.com/luispellizzon/pangram/internal/dict"
@@ -12,23 +14,33 @@ import (
 	"github.com/luispellizzon/pangram/internal/logger"
 	"github.com/luispellizzon/pangram/internal/manager"
 	"github.com/luispellizzon/pangram/internal/pangram"
+	"github.com/luispellizzon/pangram/internal/rank"
 	"github.com/luispellizzon/pangram/internal/score"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // Server with GameManager service and manager singleton
 type server struct {
 	gamepb.UnimplementedGameManagerServer
 	mgr manager.Manager
+	factory *games.Factory
 }
 // Implementation of CreateGame function from GameManager proto service 
 func (s *server) CreateGame(ctx context.Context, req *gamepb.CreateGameRequest) (*gamepb.CreateGameResponse, error) {
 	// Create new Game, use manager singleton to handle the creation
-	game_id, game, err := s.mgr.Create(req.GetKind())
-	if err != nil { return nil, err }
+	if req.GetKind() == "" { return nil, invalidField("kind", "game kind is required") }
+	options := fromOptions(req.GetOptions())
+	game_id, game, tokens, err := s.mgr.Create(req.GetKind(), options, req.GetPlayer(), req.GetShare())
+	if err != nil { return nil, createError(req.GetKind(), boardOf(options, s.factory.Defaults), err) }
 
 	// Get game information, which is created using the GameBoard singleton
 	letters, center := game.Info()
+	state := game.State()
+	answers, pangrams, maxScore := game.Goal()
 
 	// Convert the letters from current pangram from rune to string since gRPC accepts only string array
 	converted_letters := make([]string, 0, len(letters))
@@ -36,66 +48,328 @@ func (s *server) CreateGame(ctx context.Context, req *gamepb.CreateGameRequest)
 	logger.Log().Infof("NEW GAME CREATED - ID: %v", game_id)
 
 	// Submit new game that contains information from current GameBoard letters, center letter and today's pangram
-	return &gamepb.CreateGameResponse{Id: game_id, Name: game.Name(), Letters: converted_letters, Center: string(center)}, nil
+	return &gamepb.CreateGameResponse{
+		Id: game_id, Name: game.Name(), Letters: converted_letters, Center: string(center),
+		Answers: int32(answers), Pangrams: int32(pangrams), MaxScore: int32(maxScore),
+		PlayerToken: tokens.Player, ShareToken: tokens.Share, InviteToken: tokens.Invite, Options: toOptions(state.Options), EndsAt: timestamp(state.EndsAt),
+	}, nil
 }
 
 // Implementation of SubmitWord function from GameManager proto service 
 func (s *server) SubmitWord(ctx context.Context, req *gamepb.SubmitWordRequest) (*gamepb.SubmitWordResponse, error) {
 	// Get game by ID using the manager singleton
-	game, ok := s.mgr.Get(req.GetId())
-	if !ok {
-		 logger.Log().Errorf("GAME NOT FOUND")
-		return nil, fmt.Errorf("game not found") 
-	}
+	if req.GetId() == "" { return nil, invalidField("id", "game id is required") }
+	game, _, err := s.mgr.Get(req.GetId(), token(ctx, req.GetToken()), manager.Play)
+	if err != nil { return nil, accessError(req.GetId(), err) }
 
 	// Submit word to current game from id
-	valid, reason, pts, total, pangram := game.Submit(req.GetWord())
+	result := game.Submit(req.GetWord())
+
+	// The game was deleted or expired while the word was on its way
+	if manager.Removed(game) { return nil, gameNotFound(req.GetId()) }
+
+	// The word was not checked, tell the client to retry instead of answering NOT_IN_DICT
+	if result.Reason == games.ReasonDictUnavailable { return nil, dictUnavailable(req.GetId()) }
 
 	// Return submission  response with points and validation
 	return &gamepb.SubmitWordResponse{
-		Valid: valid, Reason: toEnum(reason), Points: int32(pts), Total: int32(total), Pangram: pangram,
+		Valid: result.Valid, Reason: toEnum(result.Reason), Points: int32(result.Points), Total: int32(result.Total), Pangram: result.Pangram,
+		MaxScore: int32(result.MaxScore), Complete: result.Complete,
+		Rank: result.Rank.Name, NextRank: result.Rank.Next, PointsToNext: int32(result.Rank.ToNext), RankUp: result.RankUp,
+		Word: result.Word, BoardPangram: result.BoardPangram, Perfect: result.Perfect,
+		Player: result.Player, PlayerPoints: int32(result.PlayerPoints), FirstBonus: int32(result.FirstBonus),
+		GameOver: result.GameOver, Winner: result.Winner, Lives: int32(result.Lives), Penalty: int32(result.Penalty),
+	}, nil
+}
+
+// Implementation of GetGame function from GameManager proto service, so clients can resume a game
+func (s *server) GetGame(ctx context.Context, req *gamepb.GetGameRequest) (*gamepb.GetGameResponse, error) {
+	if req.GetId() == "" { return nil, invalidField("id", "game id is required") }
+	game, access, err := s.mgr.Get(req.GetId(), token(ctx, req.GetToken()), manager.Watch)
+	if err != nil { return nil, accessError(req.GetId(), err) }
+	state := game.State()
+	converted_letters := make([]string, 0, len(state.Letters))
+	for _, char := range state.Letters { converted_letters = append(converted_letters, string(char)) }
+
+	return &gamepb.GetGameResponse{
+		Id: req.GetId(), Name: state.Name, Letters: converted_letters, Center: string(state.Center),
+		Found: state.Found, Total: int32(state.Total),
+		Rank: state.Rank.Name, NextRank: state.Rank.Next, PointsToNext: int32(state.Rank.ToNext),
+		Answers: int32(state.Answers), Pangrams: int32(state.Pangrams), MaxScore: int32(state.MaxScore),
+		CreatedAt: timestamppb.New(state.Created), ReadOnly: access == manager.Watch, Options: toOptions(state.Options),
+		Players: toPlayers(state.Players), Player: manager.PlayerOf(game),
+		Ended: state.Ended, Winner: state.Winner, EndsAt: timestamp(state.EndsAt), Lives: int32(state.Lives),
 	}, nil
 }
 
+// Implementation of JoinGame function from GameManager proto service, adds a named player to a multiplayer game
+func (s *server) JoinGame(ctx context.Context, req *gamepb.JoinGameRequest) (*gamepb.JoinGameResponse, error) {
+	if req.GetId() == "" { return nil, invalidField("id", "game id is required") }
+	playerToken, game, err := s.mgr.Join(req.GetId(), token(ctx, req.GetToken()), req.GetPlayer())
+	if err != nil { return nil, joinError(req.GetId(), err) }
+	logger.Log().Infof("PLAYER %q JOINED GAME - ID: %s", manager.PlayerOf(game), req.GetId())
+	return &gamepb.JoinGameResponse{PlayerToken: playerToken, Player: manager.PlayerOf(game)}, nil
+}
+
+// Implementation of DeleteGame function from GameManager proto service, frees the game from memory and storage
+func (s *server) DeleteGame(ctx context.Context, req *gamepb.DeleteGameRequest) (*gamepb.DeleteGameResponse, error) {
+	if req.GetId() == "" { return nil, invalidField("id", "game id is required") }
+	if _, _, err := s.mgr.Get(req.GetId(), token(ctx, req.GetToken()), manager.Own); err != nil { return nil, accessError(req.GetId(), err) }
+	if err := s.mgr.Delete(req.GetId()); err != nil {
+		if errors.Is(err, manager.ErrNotFound) { return nil, gameNotFound(req.GetId()) }
+		logger.Log().Errorf("DELETE GAME %s: %v", req.GetId(), err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	logger.Log().Infof("GAME DELETED - ID: %s", req.GetId())
+	return &gamepb.DeleteGameResponse{}, nil
+}
+
+// Implementation of EndGame function from GameManager proto service, gives up a game so its answers can be revealed
+func (s *server) EndGame(ctx context.Context, req *gamepb.EndGameRequest) (*gamepb.EndGameResponse, error) {
+	if req.GetId() == "" { return nil, invalidField("id", "game id is required") }
+	if err := s.mgr.End(req.GetId(), token(ctx, req.GetToken())); err != nil { return nil, endError(req.GetId(), err) }
+	logger.Log().Infof("GAME ENDED - ID: %s", req.GetId())
+	return &gamepb.EndGameResponse{}, nil
+}
+
+// Implementation of LeaveGame function from GameManager proto service
+func (s *server) LeaveGame(ctx context.Context, req *gamepb.LeaveGameRequest) (*gamepb.LeaveGameResponse, error) {
+	if req.GetId() == "" { return nil, invalidField("id", "game id is required") }
+	if err := s.mgr.Leave(req.GetId(), token(ctx, req.GetToken())); err != nil { return nil, joinError(req.GetId(), err) }
+	return &gamepb.LeaveGameResponse{}, nil
+}
+
+// Implementation of WatchGame function from GameManager proto service, streams the game events until the client goes away or the game is deleted
+func (s *server) WatchGame(req *gamepb.WatchGameRequest, stream grpc.ServerStreamingServer[gamepb.GameEvent]) error {
+	if req.GetId() == "" { return invalidField("id", "game id is required") }
+	watcher, err := s.mgr.Watch(req.GetId(), token(stream.Context(), req.GetToken()))
+	if err != nil { return accessError(req.GetId(), err) }
+	defer watcher.Close()
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case event, ok := <-watcher.Events:
+			if !ok { return watchError(req.GetId(), watcher.Err()) }
+			if err := stream.Send(toEvent(event)); err != nil { return err }
+		}
+	}
+}
+
+// Implementation of GetHints function from GameManager proto service, counts of the words left so players get a nudge without the answers
+func (s *server) GetHints(ctx context.Context, req *gamepb.GetHintsRequest) (*gamepb.GetHintsResponse, error) {
+	if req.GetId() == "" { return nil, invalidField("id", "game id is required") }
+	game, _, err := s.mgr.Get(req.GetId(), token(ctx, req.GetToken()), manager.Watch)
+	if err != nil { return nil, accessError(req.GetId(), err) }
+	return toHints(games.Hints(game)), nil
+}
+
+func toHints(hints pangram.Hints) *gamepb.GetHintsResponse {
+	response := &gamepb.GetHintsResponse{Words: int32(hints.Words), Pangrams: int32(hints.Pangrams)}
+	for _, n := range hints.Lengths { response.Lengths = append(response.Lengths, int32(n)) }
+	for _, row := range hints.Rows {
+		byLength := make(map[int32]int32, len(row.ByLength))
+		for n, count := range row.ByLength { byLength[int32(n)] = int32(count) }
+		response.Rows = append(response.Rows, &gamepb.HintRow{Letter: string(row.Letter), ByLength: byLength, Total: int32(row.Total)})
+	}
+	for _, prefix := range hints.Prefixes {
+		response.Prefixes = append(response.Prefixes, &gamepb.PrefixCount{Prefix: prefix.Prefix, Count: int32(prefix.Count)})
+	}
+	return response
+}
+
+// Implementation of RevealAnswers function from GameManager proto service, the words found and missed once a game ended or its board rotated
+func (s *server) RevealAnswers(ctx context.Context, req *gamepb.RevealAnswersRequest) (*gamepb.RevealAnswersResponse, error) {
+	if req.GetId() == "" { return nil, invalidField("id", "game id is required") }
+	game, _, err := s.mgr.Get(req.GetId(), token(ctx, req.GetToken()), manager.Watch)
+	if err != nil { return nil, accessError(req.GetId(), err) }
+	// without today's board no game counts as played on a past board, only finished games are revealed
+	var today time.Time
+	if daily, err := s.factory.Board.Board(); err == nil { today = daily.Date }
+	answers, err := games.Reveal(game, today)
+	if err != nil { return nil, revealError(req.GetId(), err) }
+	return &gamepb.RevealAnswersResponse{
+		Found: toAnswers(answers.Found), Missed: toAnswers(answers.Missed),
+		FoundPoints: int32(answers.FoundPoints), MissedPoints: int32(answers.MissedPoints),
+	}, nil
+}
+
+func toAnswers(answers []games.Answer) []*gamepb.Answer {
+	converted := make([]*gamepb.Answer, 0, len(answers))
+	for _, answer := range answers {
+		converted = append(converted, &gamepb.Answer{Word: answer.Word, Points: int32(answer.Points), Pangram: answer.Pangram})
+	}
+	return converted
+}
+
+// Unset for a zero time, so clients can tell a game without a time limit
+func timestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() { return nil }
+	return timestamppb.New(t)
+}
+
+func toEvent(event manager.Event) *gamepb.GameEvent {
+	return &gamepb.GameEvent{
+		Kind: gamepb.EventKind(event.Kind), Player: event.Player, Word: event.Word, Points: int32(event.Points), Pangram: event.Pangram,
+		Total: int32(event.Total), PlayerPoints: int32(event.PlayerPoints), Rank: event.Rank, Winner: event.Winner, At: timestamppb.New(event.At),
+	}
+}
+
+// Implementation of ListGameKinds function from GameManager proto service, clients build their mode prompt from it
+func (s *server) ListGameKinds(ctx context.Context, req *gamepb.ListGameKindsRequest) (*gamepb.ListGameKindsResponse, error) {
+	kinds := s.factory.Kinds()
+	response := &gamepb.ListGameKindsResponse{Kinds: make([]*gamepb.GameKind, 0, len(kinds))}
+	for _, kind := range kinds {
+		options := make([]*gamepb.GameOption, 0, len(kind.Options))
+		for _, option := range kind.Options {
+			options = append(options, &gamepb.GameOption{Name: option.Name, Type: option.Type, DefaultValue: option.Default, Description: option.Description})
+		}
+		response.Kinds = append(response.Kinds, &gamepb.GameKind{Name: kind.Name, Description: kind.Description, Options: options, Available: kind.Available(), Players: kind.Players})
+	}
+	return response, nil
+}
+
+// Token from the request field, or from the x-game-token metadata when the field is empty
+func token(ctx context.Context, field string) string {
+	if field != "" { return field }
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("x-game-token"); len(values) > 0 { return values[0] }
+	}
+	return ""
+}
+
+// Options sent by the client, the factory fills the defaults and checks the limits
+func fromOptions(options *gamepb.GameOptions) games.Options {
+	return games.Options{
+		MinLength: int(options.GetMinLength()), Scoring: options.GetScoring(), PangramBonus: int(options.GetPangramBonus()),
+		TimeLimit: time.Duration(options.GetTimeLimitSeconds()) * time.Second, Board: options.GetBoard(),
+		TargetScore: int(options.GetTargetScore()), FirstBonus: int(options.GetFirstBonus()),
+		Lives: int(options.GetLives()), MissPenalty: int(options.GetMissPenalty()), Puzzle: int(options.GetPuzzle()),
+	}
+}
+
+func toOptions(options games.Options) *gamepb.GameOptions {
+	return &gamepb.GameOptions{
+		MinLength: int32(options.MinLength), Scoring: options.Scoring, PangramBonus: int32(options.PangramBonus),
+		TimeLimitSeconds: int32(options.TimeLimit / time.Second), Board: options.Board,
+		TargetScore: int32(options.TargetScore), FirstBonus: int32(options.FirstBonus),
+		Lives: int32(options.Lives), MissPenalty: int32(options.MissPenalty), Puzzle: int32(options.Puzzle),
+	}
+}
+
+func toPlayers(players []games.Player) []*gamepb.Player {
+	converted := make([]*gamepb.Player, 0, len(players))
+	for _, player := range players {
+		converted = append(converted, &gamepb.Player{
+			Name: player.Name, Found: player.Found, Points: int32(player.Points), Words: int32(player.Words), Bonus: int32(player.Bonus), Left: player.Left,
+		})
+	}
+	return converted
+}
+
 // Return responses as enum code. Following the same response architecture from Firebase, for example, to debug easy any response.
-func toEnum(response string) gamepb.WordResult {
-	switch response {
-	case "OK": return gamepb.WordResult_OK
-	case "TOO_SHORT": return gamepb.WordResult_TOO_SHORT
-	case "INVALID_LETTER": return gamepb.WordResult_INVALID_LETTER
-	case "MISSING_CENTER": return gamepb.WordResult_MISSING_CENTER
-	case "NOT_IN_DICT": return gamepb.WordResult_NOT_IN_DICT
-	case "DUPLICATE": return gamepb.WordResult_DUPLICATE
-	default: return gamepb.WordResult_ERROR
+func toEnum(reason games.Reason) gamepb.WordResult {
+	switch reason {
+	case games.ReasonOK: return gamepb.WordResult_OK
+	case games.ReasonTooShort: return gamepb.WordResult_TOO_SHORT
+	case games.ReasonInvalidLetter: return gamepb.WordResult_INVALID_LETTER
+	case games.ReasonMissingCenter: return gamepb.WordResult_MISSING_CENTER
+	case games.ReasonNotInDict: return gamepb.WordResult_NOT_IN_DICT
+	case games.ReasonDuplicate: return gamepb.WordResult_DUPLICATE
+	case games.ReasonDictUnavailable: return gamepb.WordResult_DICT_UNAVAILABLE
+	case games.ReasonGameOver: return gamepb.WordResult_GAME_OVER
+	case games.ReasonTimeUp: return gamepb.WordResult_TIME_UP
+	case games.ReasonError: return gamepb.WordResult_ERROR
+	default:
+		logger.Log().Errorf("NO WORD RESULT FOR REASON %d", reason)
+		return gamepb.WordResult_ERROR
 	}
 }
 
 func main() {
+	dictPath := flag.String("dict", "assets/words_dictionary.json", "--dict dictionary file: JSON object or newline-delimited word list, optionally gzip-compressed")
+	dictTrie := flag.Bool("dict_trie", false, "--dict_trie keep the dictionary as a DAWG instead of a map, uses less memory")
+	epoch := flag.String("epoch", "2024-01-01", "--epoch date (YYYY-MM-DD) of puzzle number one, must match on every replica")
+	tz := flag.String("tz", "UTC", "--tz timezone where the daily board rotates at midnight, must match on every replica")
+	boardLetters := flag.Int("board_letters", 7, "--board_letters number of distinct letters every board must have")
+	minAnswers := flag.Int("min_answers", 20, "--min_answers minimum dictionary answers the center letter must give")
+	cacheSize := flag.Int("cache_size", 1000, "--cache_size number of dictionary lookups kept in the LRU cache, 0 disables it")
+	cacheTTL := flag.Duration("cache_ttl", 0, "--cache_ttl how long a cached lookup stays valid, 0 keeps it until evicted")
+	cacheNegative := flag.Bool("cache_negative", true, "--cache_negative also cache words that are not in the dictionary")
+	cacheStats := flag.Duration("cache_stats", 5*time.Minute, "--cache_stats how often cache hits/misses/evictions are logged, 0 disables it")
+	gameTTL := flag.Duration("game_ttl", 24*time.Hour, "--game_ttl games idle for longer are removed, 0 keeps them forever")
+	maxGames := flag.Int("max_games", 10000, "--max_games maximum live games, 0 for no limit")
+	dataDir := flag.String("data_dir", "", "--data_dir directory where games are saved to survive restarts, empty keeps games in memory only")
+	ranksSpec := flag.String("ranks", "", "--ranks ladder as Name:percent pairs, e.g. \"Beginner:0,Genius:70,Queen Bee:100\" (default Spelling Bee ranks)")
+	flag.Parse()
 
 	// Init repository, and intercept with Cache proxy
-	dictPath := "assets/words_dictionary.json"
-	data, err := dict.NewJSONAdapter(dictPath)
-	if err != nil { logger.Log().Errorf("DICTIONARY: %v", err)}
-	repo := dict.NewCacheProxy(data, 5)
+	data, err := dict.Open(*dictPath)
+	if err != nil {
+		logger.Log().Errorf("DICTIONARY: %v", err)
+		data = dict.Unavailable{Err: err}
+	}
+	if lister, ok := data.(dict.Lister); ok && *dictTrie {
+		trie := dict.NewTrie(lister.Words())
+		logger.Log().Infof("DICTIONARY: %d words loaded as a DAWG", trie.Len())
+		data = trie
+	}
+	repo := dict.NewCacheProxyWithConfig(data, dict.CacheConfig{Capacity: *cacheSize, TTL: *cacheTTL, Negatives: *cacheNegative})
+	if *cacheStats > 0 {
+		go func() {
+			for range time.Tick(*cacheStats) {
+				stats := repo.Stats()
+				logger.Log().Infof("CACHE: %d/%d words, %d hits, %d misses, %d evictions", stats.Size, stats.Capacity, stats.Hits, stats.Misses, stats.Evictions)
+			}
+		}()
+	}
 
 	// Init Game Board singleton for all users
 	pangramPath := "assets/pangrams.json"
 	words, err := pangram.LoadPangramsJSON(pangramPath)
 	if err != nil || len(words) == 0 { logger.Log().Errorf("PANGRAMS: %v", err); panic(err) }
-	src := pangram.CurrentTodaysPangram{Words: words}
-	pangram.InitSource(src)
 
-	// Init Scorer strategy
-	scorer := score.BonusScorer{Inner: score.BasicScorer{}, Bonus: 7}
+	// Validate pangrams before serving any game. Without a dictionary we can only check the letters
+	validator := pangram.Validator{Letters: *boardLetters, MinAnswers: *minAnswers}
+	if lister, ok := data.(dict.Lister); ok { validator.Index = pangram.NewIndex(lister.Words(), 4) }
+	candidates, skipped := validator.Validate(words)
+	for i, entry := range skipped {
+		if i == 20 { logger.Log().Errorf("PANGRAMS: ... and %d more skipped", len(skipped)-i); break }
+		logger.Log().Errorf("PANGRAM SKIPPED: %q %s", entry.Word, entry.Reason)
+	}
+	logger.Log().Infof("PANGRAMS: %d valid, %d skipped", len(candidates), len(skipped))
+	if len(candidates) == 0 { logger.Log().Errorf("PANGRAMS: no valid pangram left"); panic("no valid pangram") }
+
+	calendar, err := pangram.NewCalendar(*epoch, *tz)
+	if err != nil { logger.Log().Errorf("CALENDAR: %v", err); panic(err) }
+	src := pangram.CurrentTodaysPangram{Candidates: candidates, Calendar: calendar, Index: validator.Index}
+	pangram.InitSource(src, calendar)
+
+	// Init rank ladder, configurable per server
+	ranks := rank.Default()
+	if *ranksSpec != "" {
+		ranks, err = rank.Parse(*ranksSpec)
+		if err != nil { logger.Log().Errorf("RANKS: %v", err); panic(err) }
+	}
+
+	// Init default game rules, games can change them with their options. Scoring is a strategy picked by name
+	defaults := games.Options{MinLength: 4, Scoring: score.Bonus, PangramBonus: 7, Board: games.BoardDaily}
 
 	// Init game Factory to create different games according to its type
-	factory := &games.Factory{Dict: repo, Scorer: scorer, Board: pangram.Provider{}}
-	mgr := manager.New(factory)
+	factory := &games.Factory{Dict: repo, Board: pangram.Provider{}, Ranks: ranks, Defaults: defaults}
+	var store manager.Store = manager.NewMemoryStore()
+	if *dataDir != "" {
+		store, err = manager.NewFileStore(*dataDir)
+		if err != nil { logger.Log().Errorf("STORE: %v", err); panic(err) }
+	}
+	mgr, err := manager.New(factory, store, manager.Config{TTL: *gameTTL, MaxGames: *maxGames})
+	if err != nil { logger.Log().Errorf("MANAGER: %v", err); panic(err) }
+	defer mgr.Close()
 
 	// Init server and GameManager service
 	lis, err := net.Listen("tcp", ":50051"); if err != nil { log.Fatal(err) }
 	s := grpc.NewServer()
-	gamepb.RegisterGameManagerServer(s, &server{mgr: mgr})
+	gamepb.RegisterGameManagerServer(s, &server{mgr: mgr, factory: factory})
 	logger.Log().Infof("LISTENING ON :50051")
 	if err := s.Serve(lis); err != nil { logger.Log().Errorf("SERVER %v", err) }
 }
\ No newline at end of file
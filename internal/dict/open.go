@@ -0,0 +1,45 @@
+package dict
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// Read a dictionary file, decompressing it when it is gzip, no matter the extension
+func readFile(path string) ([]byte, error) {
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err != nil { return nil, err }
+	if !bytes.HasPrefix(data, gzipMagic) { return data, nil }
+	reader, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil { return nil, err }
+	defer reader.Close()
+	return io.ReadAll(reader)
+}
+
+// Open picks the adapter for a dictionary file. The extension decides first (.json, .txt, optionally followed by .gz), otherwise the content is sniffed: a JSON object starts with {
+func Open(path string) (Repository, error) {
+	ext := strings.ToLower(filepath.Ext(strings.TrimSuffix(strings.ToLower(path), ".gz")))
+	// return a nil Repository on errors, not a typed nil adapter
+	switch ext {
+	case ".json":
+		words, err := loadJSON(path); if err != nil { return nil, err }
+		return &JSONAdapter{inner: words}, nil
+	case ".txt":
+		words, err := loadText(path); if err != nil { return nil, err }
+		return &TextAdapter{inner: words}, nil
+	}
+	data, err := readFile(path)
+	if err != nil { return nil, err }
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		words, err := parseJSON(data); if err != nil { return nil, err }
+		return &JSONAdapter{inner: words}, nil
+	}
+	words, err := parseText(data); if err != nil { return nil, err }
+	return &TextAdapter{inner: words}, nil
+}
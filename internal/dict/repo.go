@@ -1,10 +1,12 @@
 package dict
 
 import (
+	"container/list"
 	"encoding/json"
-	"os"
-	"path/filepath"
+	"fmt"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/luispellizzon/pangram/internal/logger"
 )
@@ -12,25 +14,50 @@ import (
 // Game repository interface
 type Repository interface { Has(word string) (bool, error) }
 
+// Lister is implemented by repositories that can enumerate every word, used to index boards and count their answers
+type Lister interface { Words() []string }
+
+// Unavailable is the repository used when the dictionary could not be loaded, every lookup fails with the load error instead of saying the word does not exist
+type Unavailable struct{ Err error }
+
+func (u Unavailable) Has(word string) (bool, error) { return false, fmt.Errorf("DICTIONARY UNAVAILABLE: %w", u.Err) }
+
 // Save data in memory
-type jsonMap struct{ data map[string]struct{} }
+type wordMap struct{ data map[string]struct{} }
+
+func (m *wordMap) add(word string) {
+	word = strings.TrimSpace(word)
+	if word != "" { m.data[strings.ToLower(word)] = struct{}{} }
+}
+
+func (m *wordMap) has(word string) bool {
+	_, ok := m.data[strings.ToLower(word)]
+	return ok
+}
+
+func (m *wordMap) words() []string {
+	words := make([]string, 0, len(m.data))
+	for word := range m.data { words = append(words, word) }
+	return words
+}
 
-// Load dictionary (.json)
-func loadJSON(path string) (*jsonMap, error) {
-	bytes, err := os.ReadFile(filepath.Clean(path))
+// Load dictionary (.json or .json.gz)
+func loadJSON(path string) (*wordMap, error) {
+	bytes, err := readFile(path)
 	if err != nil { return nil, err }
+	return parseJSON(bytes)
+}
+
+func parseJSON(bytes []byte) (*wordMap, error) {
 	var objJSON map[string]any
 	if err := json.Unmarshal(bytes, &objJSON); err != nil { return nil, err }
-	mapper := make(map[string]struct{}, len(objJSON))
-	for key := range objJSON {
-		key = strings.TrimSpace(key)
-		mapper[strings.ToLower(key)] = struct{}{}
-	}
-	return &jsonMap{data: mapper}, nil
+	mapper := &wordMap{data: make(map[string]struct{}, len(objJSON))}
+	for key := range objJSON { mapper.add(key) }
+	return mapper, nil
 }
 
 // Adapter 
-type JSONAdapter struct{ inner *jsonMap }
+type JSONAdapter struct{ inner *wordMap }
 
 // Create a new repository using a json adapter, where it will convert json file, into a map in memory
 func NewJSONAdapter(path string) (*JSONAdapter, error) {
@@ -39,48 +66,115 @@ func NewJSONAdapter(path string) (*JSONAdapter, error) {
 }
 
 // Implement repository
-func (a *JSONAdapter) Has(word string) (bool, error) {
-	_, ok := a.inner.data[strings.ToLower(word)]
-	return ok, nil
-}
+func (a *JSONAdapter) Has(word string) (bool, error) { return a.inner.has(word), nil }
+
+// Words returns every word of the dictionary
+func (a *JSONAdapter) Words() []string { return a.inner.words() }
 
 // Cache proxy where it will take as a dependency the repository, so we can intercept requests before forward them to other layers of the server to check the dictionary
+// Every game shares the same proxy across gRPC goroutines, so the LRU list and the stats are guarded by a mutex
 type CacheProxy struct {
 	repo Repository
-	cache map[string]bool
-	order []string
-	capacity   int
+	mu sync.Mutex
+	cache map[string]*list.Element
+	order *list.List // most recently used at the front
+	config CacheConfig
+	now func() time.Time
+	hits, misses, evictions uint64
+}
+
+// CacheConfig tunes the proxy. Capacity 0 disables caching
+type CacheConfig struct {
+	Capacity int
+	TTL time.Duration // 0 keeps words until they are evicted
+	Negatives bool // also cache words that are not in the dictionary
 }
 
-// Create proxy
+// CacheStats helps operators size the capacity from real traffic
+type CacheStats struct {
+	Hits, Misses, Evictions uint64
+	Size, Capacity int
+}
+
+type cacheEntry struct {
+	word string
+	valid bool
+	expires time.Time
+}
+
+// Create proxy, caching both valid and invalid words without expiry
 func NewCacheProxy(repo Repository, capacity int) *CacheProxy {
-	return &CacheProxy{repo: repo, cache: map[string]bool{}, capacity: capacity}
+	return NewCacheProxyWithConfig(repo, CacheConfig{Capacity: capacity, Negatives: true})
+}
+
+func NewCacheProxyWithConfig(repo Repository, config CacheConfig) *CacheProxy {
+	return &CacheProxy{repo: repo, cache: map[string]*list.Element{}, order: list.New(), config: config, now: time.Now}
 }
 
 // Implement the same Repository interface, but the function will cache words that were already submitted so users that submits words that were checked before, will hit the cache without the need of reading the full repository where the full dictionary is loaded
 func (p *CacheProxy) Has(word string) (bool, error) {
 	pangram := strings.ToLower(word)
-	isValid, ok := p.cache[pangram]
-	if ok { 
+	if isValid, ok := p.lookup(pangram); ok {
 		logger.Log().Infof("FROM CACHE: %v", word)
-		return isValid, nil 
+		return isValid, nil
 	}
+
+	// The repository is called without holding the lock, so a slow dictionary does not block cache hits
 	isValid, err := p.repo.Has(pangram)
 	logger.Log().Infof("FROM DATABASE (REPOSITORY): %v", word)
+	// errors are never cached, the next lookup asks the repository again
 	if err != nil { 
-		logger.Log().Errorf("FROM CACHE: %v", word)
+		logger.Log().Errorf("FROM DATABASE (REPOSITORY): %v: %v", word, err)
 		return false, err 
 	}
+	p.store(pangram, isValid)
+	return isValid, nil
+}
 
-	// add to cache and clean older values. I set the capacity of the cache to be 5 words just for test purposes so I could see older values being cleaned. 
-	p.cache[pangram] = isValid
-	p.order = append(p.order, pangram)
+// Find a word in the cache and mark it as the most recently used
+func (p *CacheProxy) lookup(word string) (bool, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	element, ok := p.cache[word]
+	if ok {
+		entry := element.Value.(*cacheEntry)
+		if entry.expires.IsZero() || p.now().Before(entry.expires) {
+			p.order.MoveToFront(element)
+			p.hits++
+			return entry.valid, true
+		}
+		// expired, drop it and ask the repository again
+		p.order.Remove(element)
+		delete(p.cache, word)
+	}
+	p.misses++
+	return false, false
+}
 
-	// Since we are using a queue way of caching, we remove the first value that from cache.
-	if len(p.order) > p.capacity {
-		old := p.order[0]
-		p.order = p.order[1:]
-		delete(p.cache, old)
+// add to cache and evict the least recently used words when over capacity
+func (p *CacheProxy) store(word string, isValid bool) {
+	if p.config.Capacity <= 0 || (!isValid && !p.config.Negatives) { return }
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	entry := &cacheEntry{word: word, valid: isValid}
+	if p.config.TTL > 0 { entry.expires = p.now().Add(p.config.TTL) }
+	if element, ok := p.cache[word]; ok {
+		element.Value = entry
+		p.order.MoveToFront(element)
+		return
 	}
-	return isValid, nil
+	p.cache[word] = p.order.PushFront(entry)
+	for p.order.Len() > p.config.Capacity {
+		oldest := p.order.Back()
+		p.order.Remove(oldest)
+		delete(p.cache, oldest.Value.(*cacheEntry).word)
+		p.evictions++
+	}
+}
+
+// Stats returns a copy of the cache counters
+func (p *CacheProxy) Stats() CacheStats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return CacheStats{Hits: p.hits, Misses: p.misses, Evictions: p.evictions, Size: p.order.Len(), Capacity: p.config.Capacity}
 }
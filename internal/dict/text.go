@@ -0,0 +1,40 @@
+package dict
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+)
+
+// Load a newline-delimited word list (.txt or .txt.gz). Blank lines and lines starting with # are skipped
+func loadText(path string) (*wordMap, error) {
+	data, err := readFile(path)
+	if err != nil { return nil, err }
+	return parseText(data)
+}
+
+func parseText(data []byte) (*wordMap, error) {
+	mapper := &wordMap{data: map[string]struct{}{}}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") { continue }
+		mapper.add(line)
+	}
+	if err := scanner.Err(); err != nil { return nil, err }
+	return mapper, nil
+}
+
+// Adapter for plain word lists, so standard lists can be used without converting them to JSON first
+type TextAdapter struct{ inner *wordMap }
+
+func NewTextAdapter(path string) (*TextAdapter, error) {
+	data, err := loadText(path); if err != nil { return nil, err }
+	return &TextAdapter{inner: data}, nil
+}
+
+// Implement repository
+func (a *TextAdapter) Has(word string) (bool, error) { return a.inner.has(word), nil }
+
+// Words returns every word of the dictionary
+func (a *TextAdapter) Words() []string { return a.inner.words() }
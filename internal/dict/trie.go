@@ -0,0 +1,162 @@
+package dict
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Trie keeps the dictionary as a DAWG (a prefix tree where equal suffixes are shared), so it uses far less memory than a map of words and can also answer prefix and letter-set queries.
+// The server only uses it as a Repository and a Lister for now, boards are solved by pangram.Index with any dictionary
+type Trie struct {
+	root *trieNode
+	size int
+}
+
+type trieNode struct {
+	id    int
+	final bool // a word ends here
+	edges []trieEdge
+}
+
+// edges are kept sorted by label because words are inserted in order
+type trieEdge struct {
+	label byte
+	next  *trieNode
+}
+
+func (n *trieNode) child(label byte) *trieNode {
+	for _, edge := range n.edges {
+		if edge.label == label { return edge.next }
+	}
+	return nil
+}
+
+// unchecked path of the last inserted word, not yet merged with equal nodes
+type trieStep struct {
+	parent *trieNode
+	label  byte
+	child  *trieNode
+}
+
+// Build the DAWG from a word list. Words are lower cased, sorted and de-duplicated first since the incremental construction needs them in order
+func NewTrie(words []string) *Trie {
+	sorted := make([]string, 0, len(words))
+	for _, word := range words {
+		word = strings.ToLower(strings.TrimSpace(word))
+		if word != "" { sorted = append(sorted, word) }
+	}
+	sort.Strings(sorted)
+
+	t := &Trie{}
+	nextID := 0
+	newNode := func() *trieNode { nextID++; return &trieNode{id: nextID} }
+	t.root = newNode()
+	register := map[string]*trieNode{}
+	unchecked := []trieStep{}
+
+	// merge the unchecked nodes deeper than the given depth with an equal node of the register
+	minimize := func(depth int) {
+		for len(unchecked) > depth {
+			step := unchecked[len(unchecked)-1]
+			key := step.child.signature()
+			if existing, ok := register[key]; ok {
+				step.parent.edges[len(step.parent.edges)-1].next = existing
+			} else {
+				register[key] = step.child
+			}
+			unchecked = unchecked[:len(unchecked)-1]
+		}
+	}
+
+	previous := ""
+	for _, word := range sorted {
+		if word == previous { continue }
+		common := 0
+		for common < len(word) && common < len(previous) && word[common] == previous[common] { common++ }
+		minimize(common)
+
+		node := t.root
+		if len(unchecked) > 0 { node = unchecked[len(unchecked)-1].child }
+		for i := common; i < len(word); i++ {
+			child := newNode()
+			node.edges = append(node.edges, trieEdge{label: word[i], next: child})
+			unchecked = append(unchecked, trieStep{parent: node, label: word[i], child: child})
+			node = child
+		}
+		node.final = true
+		t.size++
+		previous = word
+	}
+	minimize(0)
+	return t
+}
+
+// Two nodes are equal when they end a word the same way and point to the same children
+func (n *trieNode) signature() string {
+	var b strings.Builder
+	if n.final { b.WriteByte('!') }
+	for _, edge := range n.edges {
+		b.WriteByte(edge.label)
+		b.WriteString(strconv.Itoa(edge.next.id))
+		b.WriteByte(',')
+	}
+	return b.String()
+}
+
+// walk down a prefix, nil when no word starts with it
+func (t *Trie) find(prefix string) *trieNode {
+	node := t.root
+	for i := 0; i < len(prefix) && node != nil; i++ { node = node.child(prefix[i]) }
+	return node
+}
+
+// Implement repository
+func (t *Trie) Has(word string) (bool, error) {
+	node := t.find(strings.ToLower(word))
+	return node != nil && node.final, nil
+}
+
+// Len is the number of words in the dictionary
+func (t *Trie) Len() int { return t.size }
+
+// HasPrefix checks if any word starts with the prefix
+func (t *Trie) HasPrefix(prefix string) bool { return t.find(strings.ToLower(prefix)) != nil }
+
+// WithPrefix returns every word starting with the prefix, sorted
+func (t *Trie) WithPrefix(prefix string) []string {
+	prefix = strings.ToLower(prefix)
+	words := []string{}
+	node := t.find(prefix)
+	if node == nil { return words }
+	node.collect([]byte(prefix), func(word []byte) bool { return true }, func(word []byte) { words = append(words, string(word)) })
+	return words
+}
+
+// Words returns every word of the dictionary, sorted
+func (t *Trie) Words() []string { return t.WithPrefix("") }
+
+// Formable returns every word of at least minLength letters that only uses the given letters (repeating them is allowed) and contains the center letter, sorted.
+// Not used by the server, board answers come from pangram.Index, which also drops words with anything outside a-z
+func (t *Trie) Formable(letters []rune, center rune, minLength int) []string {
+	allowed := [256]bool{}
+	for _, r := range letters {
+		if r < 256 { allowed[r] = true }
+	}
+	words := []string{}
+	c := byte(center)
+	t.root.collect(nil, func(word []byte) bool { return allowed[word[len(word)-1]] }, func(word []byte) {
+		if len(word) >= minLength && strings.IndexByte(string(word), c) >= 0 { words = append(words, string(word)) }
+	})
+	return words
+}
+
+// depth-first walk in label order, only following edges the filter accepts
+func (n *trieNode) collect(prefix []byte, follow func(word []byte) bool, emit func(word []byte)) {
+	if n.final { emit(prefix) }
+	for _, edge := range n.edges {
+		word := append(prefix, edge.label)
+		if !follow(word) { continue }
+		edge.next.collect(word, follow, emit)
+	}
+}
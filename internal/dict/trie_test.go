@@ -0,0 +1,134 @@
+package dict
+
+import (
+	"math/rand"
+	"runtime"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// Word list shared by the tests and benchmarks. Random stems with common English endings, so the DAWG has real suffixes to share like a dictionary does
+func wordList(n int) []string {
+	rng := rand.New(rand.NewSource(1))
+	suffixes := []string{"", "s", "ed", "ing", "er", "ers", "ly", "ness"}
+	seen := map[string]struct{}{}
+	words := make([]string, 0, n)
+	for len(words) < n {
+		stem := make([]byte, 3+rng.Intn(6))
+		for i := range stem { stem[i] = byte('a' + rng.Intn(26)) }
+		for _, suffix := range suffixes {
+			word := string(stem) + suffix
+			if _, ok := seen[word]; ok || len(words) == n { continue }
+			seen[word] = struct{}{}
+			words = append(words, word)
+		}
+	}
+	return words
+}
+
+func newMapAdapter(words []string) *JSONAdapter {
+	inner := &wordMap{data: make(map[string]struct{}, len(words))}
+	for _, word := range words { inner.add(word) }
+	return &JSONAdapter{inner: inner}
+}
+
+func TestTrieHas(t *testing.T) {
+	words := wordList(5000)
+	trie := NewTrie(append(words, words[0], "  "+strings.ToUpper(words[1])+" "))
+	if trie.Len() != len(words) { t.Fatalf("Len() = %d, want %d", trie.Len(), len(words)) }
+	for _, word := range words {
+		if ok, _ := trie.Has(word); !ok { t.Fatalf("Has(%q) = false", word) }
+	}
+	if ok, _ := trie.Has(strings.ToUpper(words[2])); !ok { t.Fatalf("Has is not case-insensitive") }
+	for _, word := range []string{"", "zzzzzzzzzzzz", words[0] + "q"} {
+		if ok, _ := trie.Has(word); ok { t.Fatalf("Has(%q) = true", word) }
+	}
+}
+
+func TestTriePrefixes(t *testing.T) {
+	words := wordList(5000)
+	trie := NewTrie(words)
+	for _, prefix := range []string{"", "a", "qu", words[10][:3], words[20], "zzzzzz"} {
+		want := []string{}
+		for _, word := range words {
+			if strings.HasPrefix(word, prefix) { want = append(want, word) }
+		}
+		sort.Strings(want)
+		got := trie.WithPrefix(strings.ToUpper(prefix))
+		if strings.Join(got, ",") != strings.Join(want, ",") { t.Fatalf("WithPrefix(%q) = %d words, want %d", prefix, len(got), len(want)) }
+		if trie.HasPrefix(prefix) != (len(want) > 0) { t.Fatalf("HasPrefix(%q) = %v, want %v", prefix, !(len(want) > 0), len(want) > 0) }
+	}
+	if got := trie.Words(); len(got) != len(words) || !sort.StringsAreSorted(got) { t.Fatalf("Words() = %d words, sorted %v", len(got), sort.StringsAreSorted(got)) }
+}
+
+func TestTrieFormable(t *testing.T) {
+	words := append(wordList(20000), "alarm", "alamo", "formally", "moral", "oral", "mall")
+	trie := NewTrie(words)
+	letters, center := []rune("formyla"), 'm'
+	allowed := map[rune]bool{}
+	for _, r := range letters { allowed[r] = true }
+	want := []string{}
+	for _, word := range words {
+		ok := len(word) >= 4 && strings.ContainsRune(word, center)
+		for _, r := range word { ok = ok && allowed[r] }
+		if ok { want = append(want, word) }
+	}
+	sort.Strings(want)
+	got := trie.Formable(letters, center, 4)
+	if strings.Join(got, ",") != strings.Join(want, ",") { t.Fatalf("Formable = %v, want %v", got, want) }
+	for _, word := range []string{"alarm", "alamo", "formally", "moral", "mall"} {
+		if sort.SearchStrings(got, word) == len(got) || got[sort.SearchStrings(got, word)] != word { t.Fatalf("Formable is missing %q", word) }
+	}
+}
+
+const benchWords = 100000
+
+func BenchmarkTrieHas(b *testing.B) {
+	words := wordList(benchWords)
+	trie := NewTrie(words)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ { trie.Has(words[i%len(words)]) }
+}
+
+func BenchmarkMapHas(b *testing.B) {
+	words := wordList(benchWords)
+	adapter := newMapAdapter(words)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ { adapter.Has(words[i%len(words)]) }
+}
+
+// Heap kept alive by a built dictionary, the memory the server pays for as long as it runs
+func retained(build func() any) uint64 {
+	var before, after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+	kept := build()
+	runtime.GC()
+	runtime.ReadMemStats(&after)
+	runtime.KeepAlive(kept)
+	if after.HeapAlloc < before.HeapAlloc { return 0 }
+	return after.HeapAlloc - before.HeapAlloc
+}
+
+// Memory benchmarks report the heap each dictionary keeps (retained-bytes) next to what building it allocates (B/op)
+func BenchmarkTrieMemory(b *testing.B) {
+	words := wordList(benchWords)
+	kept := retained(func() any { return NewTrie(words) })
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ { NewTrie(words) }
+	// reported after the loop, ResetTimer drops metrics reported before it
+	b.ReportMetric(float64(kept), "retained-bytes")
+}
+
+func BenchmarkMapMemory(b *testing.B) {
+	words := wordList(benchWords)
+	kept := retained(func() any { return newMapAdapter(words) })
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ { newMapAdapter(words) }
+	b.ReportMetric(float64(kept), "retained-bytes")
+}
@@ -0,0 +1,19 @@
+package games
+
+import "errors"
+
+// Errors returned by the factory, so callers can tell what went wrong without matching messages
+var (
+	ErrUnknownKind      = errors.New("GAME NOT IMPLEMENTED")
+	ErrKindNotAvailable = errors.New("GAME NOT AVAILABLE YET")
+	ErrNoBoard          = errors.New("GAME BOARD UNAVAILABLE")
+	ErrInvalidOption    = errors.New("INVALID GAME OPTION")
+	ErrNoPlayers        = errors.New("GAME CAN NOT BE JOINED")
+	ErrBadPlayer        = errors.New("INVALID PLAYER NAME")
+	ErrPlayerTaken      = errors.New("PLAYER NAME ALREADY IN THE GAME")
+	ErrGameFull         = errors.New("GAME IS FULL")
+	ErrGameOver         = errors.New("GAME IS OVER")
+	ErrNoPlayer         = errors.New("PLAYER IS NOT IN THE GAME")
+	ErrNoEnd            = errors.New("GAME ENDS ON ITS OWN")
+	ErrAnswersHidden    = errors.New("ANSWERS ARE HIDDEN UNTIL THE GAME ENDS OR THE BOARD ROTATES")
+)
@@ -1,35 +1,105 @@
 package games
 
 import (
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/luispellizzon/pangram/internal/dict"
 	"github.com/luispellizzon/pangram/internal/pangram"
-	"github.com/luispellizzon/pangram/internal/score"
+	"github.com/luispellizzon/pangram/internal/rank"
 )
 
 // Provider interface. Decided to use a interface to decouple the GameBoard itself so I do not need to use the GameBoard direct in the factory, but pass as a dependency interface
 type IBoardProvider interface{
 	Board() (pangram.GameBoard, error)
+	BoardFor(date string) (pangram.GameBoard, error)
+	Puzzle(number int) (pangram.GameBoard, error)
+	Random() (pangram.GameBoard, error)
 }
 
-// Server factory to create games
+// Server factory to create games. Kinds are resolved through the registry, the default one when none is given.
+// Defaults are the rules of a game that does not send its own options
 type Factory struct {
 	Dict   dict.Repository
-	Scorer score.Scorer
 	Board IBoardProvider
+	Ranks rank.Ladder
+	Defaults Options
+	Registry *Registry
+	Now func() time.Time // nil uses the wall clock
 }
 
-// Create game. For now is only singleplayer
-func (f *Factory) New(kind string) (Game, error) {
-	board, err := f.Board.Board()
-	if err != nil {return nil, err}
-	switch kind {
-	case "singleplayer":
-		return NewPangramSingle(board, f.Dict, f.Scorer), nil
-	case "multiplayer":
-		return nil, fmt.Errorf("MULTIPLAYER NOT IMPLEMENTED")
+func (f *Factory) registry() *Registry {
+	if f.Registry == nil { return defaultRegistry }
+	return f.Registry
+}
+
+// Kinds the factory can list to clients, options without a default of their own show the factory default
+func (f *Factory) Kinds() []Kind {
+	kinds := f.registry().Kinds()
+	defaults := Options{}.withDefaults(f.Defaults)
+	for i, kind := range kinds {
+		specs := make([]OptionSpec, len(kind.Options))
+		for j, spec := range kind.Options {
+			if spec.Default == "" { spec.Default = defaults.value(spec.Name) }
+			specs[j] = spec
+		}
+		kinds[i].Options = specs
+	}
+	return kinds
+}
+
+func (f *Factory) kind(name string) (Kind, error) {
+	kind, ok := f.registry().Lookup(name)
+	if !ok { return Kind{}, fmt.Errorf("%w: %q", ErrUnknownKind, name) }
+	if !kind.Available() { return Kind{}, fmt.Errorf("%w: %s", ErrKindNotAvailable, name) }
+	return kind, nil
+}
+
+// Create game of a registered kind. Options are checked against the kind before any board is built
+func (f *Factory) New(name string, opts Options) (Game, error) {
+	kind, err := f.kind(name)
+	if err != nil { return nil, err }
+	if err := opts.validate(kind); err != nil { return nil, err }
+	opts = opts.withDefaults(f.Defaults)
+	board, err := f.board(opts)
+	if err != nil { return nil, err }
+	// the puzzle number and its date are kept with the rules, so players can tell which puzzle they played
+	if opts.Puzzle != 0 { opts.Board = board.Date.Format(time.DateOnly) }
+	opts.Puzzle = board.Number
+	return kind.New(f.setup(board.WithMinLength(opts.MinLength), opts))
+}
+
+// Board picked by the board or the puzzle option
+func (f *Factory) board(opts Options) (pangram.GameBoard, error) {
+	var board pangram.GameBoard
+	var err error
+	switch {
+	case opts.Puzzle != 0:
+		board, err = f.Board.Puzzle(opts.Puzzle)
+		if errors.Is(err, pangram.ErrBadDate) { return board, invalidOption(OptionPuzzle, "%v", err) }
+	case opts.Board == BoardDaily:
+		board, err = f.Board.Board()
+	case opts.Board == BoardRandom:
+		board, err = f.Board.Random()
 	default:
-		return nil, fmt.Errorf("GAME NOT IMPLEMENTED: %s", kind)
+		board, err = f.Board.BoardFor(opts.Board)
+		if errors.Is(err, pangram.ErrBadDate) { return board, invalidOption(OptionBoard, "%v", err) }
 	}
+	if err != nil { return board, fmt.Errorf("%w: %v", ErrNoBoard, err) }
+	return board, nil
+}
+
+func (f *Factory) setup(board pangram.GameBoard, opts Options) Setup {
+	now := f.Now
+	if now == nil { now = time.Now }
+	return Setup{Board: board, Dict: f.Dict, Scorer: opts.scorer(), Ranks: f.Ranks, Options: opts, Now: now}
+}
+
+// Rebuild a saved game with the factory dependencies. Games saved before they had options get the defaults
+func (f *Factory) Restore(snap Snapshot) (Game, error) {
+	kind, err := f.kind(snap.Kind)
+	if err != nil { return nil, err }
+	if kind.Restore == nil { return nil, fmt.Errorf("%w: %s can not be restored", ErrKindNotAvailable, snap.Kind) }
+	return kind.Restore(f.setup(snap.Board(), snap.Options.withDefaults(f.Defaults)), snap)
 }
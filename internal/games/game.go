@@ -1,8 +1,66 @@
 package games
 
+import (
+	"time"
+
+	"github.com/luispellizzon/pangram/internal/pangram"
+	"github.com/luispellizzon/pangram/internal/rank"
+)
+
 // This is how the Game itself will work, then later pangramGame will implement this interface and we can branch to pangramSinglePlayer and pangramMultiPlayer concrete classes that will implement this interface for each type of game
 type Game interface {
 	Name() string
 	Info() (letters []rune, center rune)
-	Submit(word string) (valid bool, reason string, points int, total int, pangram bool)
+	Goal() (answers int, pangrams int, maxScore int)
+	State() State
+	Snapshot() Snapshot
+	Submit(word string) SubmitResult
+}
+
+// WithPlayers is implemented by games that several named players join. Submit and State of the Game interface play and see the game as the first player
+type WithPlayers interface {
+	Join(player string) (string, error) // returns the name the player plays under, trimmed and as first written when a player comes back
+	Leave(player string) error
+	SubmitAs(player string, word string) SubmitResult
+	StateAs(player string) State
+	Players() []Player
+}
+
+// Player of a game and what the player added to it
+type Player struct {
+	Name   string   `json:"name"`
+	Found  []string `json:"found"` // words this player found, in order. Hidden from the other players of a race until it ends
+	Words  int      `json:"words"`
+	Points int      `json:"points"`
+	Bonus  int      `json:"bonus,omitempty"` // first-finder points, already in Points
+	Left   bool     `json:"left,omitempty"`  // left the game, the words and points stay. Joining again with the same name comes back
+}
+
+// Secret is implemented by games where players must not see the words of the other players yet
+type Secret interface { Secret() bool }
+
+// Ender is implemented by games that have no end of their own, no time limit, lives or target. Ending one is giving up: no more words are accepted and the answers can be revealed
+type Ender interface { End() }
+
+// Hints of the words left in a game. The found words come from State, so a race player only gets hints for the words they have not found
+func Hints(game Game) pangram.Hints { return game.Snapshot().Board().Hints(game.State().Found) }
+
+// State is everything a client needs to resume a game
+type State struct {
+	Name     string
+	Letters  []rune
+	Center   rune
+	Found    []string // in the order they were found
+	Total    int
+	Answers  int
+	Pangrams int
+	MaxScore int
+	Rank     rank.Standing
+	Created  time.Time
+	Options  Options // rules the game was created with
+	Players  []Player // empty for games without named players, ordered by points in a race
+	Ended    bool
+	Winner   string // empty while playing or when the best players tied
+	EndsAt   time.Time // zero when the game has no time limit
+	Lives    int       // lives left in a hardcore game
 }
@@ -0,0 +1,187 @@
+package games
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/luispellizzon/pangram/internal/score"
+)
+
+// Option names, the same ones kinds list in their OptionSpec and clients send when creating a game
+const (
+	OptionMinLength    = "min_length"
+	OptionScoring      = "scoring"
+	OptionPangramBonus = "pangram_bonus"
+	OptionTimeLimit    = "time_limit"
+	OptionBoard        = "board"
+	OptionTargetScore  = "target_score"
+	OptionFirstBonus   = "first_bonus"
+	OptionLives        = "lives"
+	OptionMissPenalty  = "miss_penalty"
+	OptionPuzzle       = "puzzle"
+)
+
+// Boards a game can be played on, any other board value must be a past date (YYYY-MM-DD)
+const (
+	BoardDaily  = "daily"
+	BoardRandom = "random"
+)
+
+// Limits of the options, so a game can not be made unplayable
+const (
+	MinWordLength = 4
+	MaxWordLength = 9
+	MaxBonus      = 50
+	MinTimeLimit  = 10 * time.Second
+	MaxTimeLimit  = 24 * time.Hour
+	MaxTarget     = 100000
+	MaxLives      = 10
+)
+
+// Options are the rules of one game. Zero values mean the factory defaults, so a client only sends what it wants to change
+type Options struct {
+	MinLength    int           `json:"min_length"`
+	Scoring      string        `json:"scoring"`
+	PangramBonus int           `json:"pangram_bonus,omitempty"`
+	TimeLimit    time.Duration `json:"time_limit,omitempty"`
+	Board        string        `json:"board"`
+	TargetScore  int           `json:"target_score,omitempty"` // the first player to reach it wins
+	FirstBonus   int           `json:"first_bonus,omitempty"`  // extra points for the first player to find a word
+	Lives        int           `json:"lives,omitempty"`        // misses a hardcore game allows
+	MissPenalty  int           `json:"miss_penalty,omitempty"` // points taken for every miss
+	Puzzle       int           `json:"puzzle,omitempty"`       // puzzle number of the board, an other way to ask for a past board
+}
+
+// OptionError tells which option was refused and why
+type OptionError struct {
+	Option string
+	Reason string
+}
+
+func (e *OptionError) Error() string { return fmt.Sprintf("%v: %s: %s", ErrInvalidOption, e.Option, e.Reason) }
+func (e *OptionError) Unwrap() error { return ErrInvalidOption }
+
+func invalidOption(option string, format string, args ...any) error {
+	return &OptionError{Option: option, Reason: fmt.Sprintf(format, args...)}
+}
+
+// Names of the options that were set, in the order they are checked
+func (o Options) set() []string {
+	names := []string{}
+	if o.MinLength != 0 { names = append(names, OptionMinLength) }
+	if o.Scoring != "" { names = append(names, OptionScoring) }
+	if o.PangramBonus != 0 { names = append(names, OptionPangramBonus) }
+	if o.TimeLimit != 0 { names = append(names, OptionTimeLimit) }
+	if o.Board != "" { names = append(names, OptionBoard) }
+	if o.TargetScore != 0 { names = append(names, OptionTargetScore) }
+	if o.FirstBonus != 0 { names = append(names, OptionFirstBonus) }
+	if o.Lives != 0 { names = append(names, OptionLives) }
+	if o.MissPenalty != 0 { names = append(names, OptionMissPenalty) }
+	if o.Puzzle != 0 { names = append(names, OptionPuzzle) }
+	return names
+}
+
+// Value of an option as clients see it in the kind list
+func (o Options) value(name string) string {
+	switch name {
+	case OptionMinLength:
+		return strconv.Itoa(o.MinLength)
+	case OptionScoring:
+		return o.Scoring
+	case OptionPangramBonus:
+		return strconv.Itoa(o.PangramBonus)
+	case OptionTimeLimit:
+		if o.TimeLimit == 0 { return "" }
+		return o.TimeLimit.String()
+	case OptionBoard:
+		return o.Board
+	case OptionTargetScore:
+		return strconv.Itoa(o.TargetScore)
+	case OptionFirstBonus:
+		return strconv.Itoa(o.FirstBonus)
+	case OptionLives:
+		return strconv.Itoa(o.Lives)
+	case OptionMissPenalty:
+		return strconv.Itoa(o.MissPenalty)
+	case OptionPuzzle:
+		if o.Puzzle == 0 { return "" }
+		return strconv.Itoa(o.Puzzle)
+	}
+	return ""
+}
+
+// Fill the options left empty with the defaults
+func (o Options) withDefaults(defaults Options) Options {
+	if o.MinLength == 0 { o.MinLength = defaults.MinLength }
+	// a bonus without a strategy asks for the bonus strategy
+	if o.Scoring == "" && o.PangramBonus != 0 { o.Scoring = score.Bonus }
+	if o.Scoring == "" { o.Scoring = defaults.Scoring }
+	if o.Scoring == score.Bonus && o.PangramBonus == 0 { o.PangramBonus = defaults.PangramBonus }
+	if o.TimeLimit == 0 { o.TimeLimit = defaults.TimeLimit }
+	if o.Board == "" { o.Board = defaults.Board }
+	if o.MinLength == 0 { o.MinLength = MinWordLength }
+	if o.Scoring == "" { o.Scoring = score.Basic }
+	if o.Scoring != score.Bonus { o.PangramBonus = 0 }
+	if o.Board == "" { o.Board = BoardDaily }
+	return o
+}
+
+// Check the options against what the kind accepts and the limits of every option
+func (o Options) validate(kind Kind) error {
+	accepted := map[string]struct{}{}
+	for _, spec := range kind.Options { accepted[spec.Name] = struct{}{} }
+	for _, name := range o.set() {
+		if _, ok := accepted[name]; !ok { return invalidOption(name, "not accepted by %s games", kind.Name) }
+	}
+	if o.MinLength != 0 && (o.MinLength < MinWordLength || o.MinLength > MaxWordLength) {
+		return invalidOption(OptionMinLength, "must be between %d and %d", MinWordLength, MaxWordLength)
+	}
+	if o.Scoring != "" {
+		if _, err := score.ByName(o.Scoring, 0); err != nil { return invalidOption(OptionScoring, "must be one of %v", score.Names()) }
+	}
+	if o.PangramBonus < 0 || o.PangramBonus > MaxBonus {
+		return invalidOption(OptionPangramBonus, "must be between 0 and %d", MaxBonus)
+	}
+	if o.PangramBonus != 0 && o.Scoring != "" && o.Scoring != score.Bonus {
+		return invalidOption(OptionPangramBonus, "only used with %s scoring", score.Bonus)
+	}
+	if o.TimeLimit != 0 && (o.TimeLimit < MinTimeLimit || o.TimeLimit > MaxTimeLimit) {
+		return invalidOption(OptionTimeLimit, "must be between %s and %s", MinTimeLimit, MaxTimeLimit)
+	}
+	if o.TargetScore < 0 || o.TargetScore > MaxTarget {
+		return invalidOption(OptionTargetScore, "must be between 0 and %d", MaxTarget)
+	}
+	if o.FirstBonus < 0 || o.FirstBonus > MaxBonus {
+		return invalidOption(OptionFirstBonus, "must be between 0 and %d", MaxBonus)
+	}
+	if o.Lives < 0 || o.Lives > MaxLives {
+		return invalidOption(OptionLives, "must be between 1 and %d", MaxLives)
+	}
+	if o.MissPenalty < 0 || o.MissPenalty > MaxBonus {
+		return invalidOption(OptionMissPenalty, "must be between 0 and %d", MaxBonus)
+	}
+	if o.Puzzle < 0 { return invalidOption(OptionPuzzle, "puzzle numbers start at 1") }
+	if o.Puzzle != 0 && o.Board != "" && o.Board != BoardDaily {
+		return invalidOption(OptionPuzzle, "give a board or a puzzle number, not both")
+	}
+	if o.Board != "" && o.Board != BoardDaily && o.Board != BoardRandom {
+		if _, err := time.Parse(time.DateOnly, o.Board); err != nil {
+			return invalidOption(OptionBoard, "must be %s, %s or a date YYYY-MM-DD", BoardDaily, BoardRandom)
+		}
+	}
+	return nil
+}
+
+// Scoring strategy of the options, wrapped in the penalty strategy when a miss costs points
+func (o Options) scorer() score.Scorer {
+	scorer, err := score.ByName(o.Scoring, o.PangramBonus)
+	if err != nil { scorer = score.BasicScorer{} }
+	if o.MissPenalty > 0 { return score.PenaltyScorer{Inner: scorer, Penalty: o.MissPenalty} }
+	return scorer
+}
+
+func (o Options) minLength() int {
+	if o.MinLength == 0 { return MinWordLength }
+	return o.MinLength
+}
@@ -2,61 +2,166 @@ package games
 
 import (
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/luispellizzon/pangram/internal/dict"
+	"github.com/luispellizzon/pangram/internal/logger"
 	"github.com/luispellizzon/pangram/internal/pangram"
+	"github.com/luispellizzon/pangram/internal/rank"
 	"github.com/luispellizzon/pangram/internal/score"
 )
 
 // Pangram Game itself to implement the Game interface. Contains attributes related to how the game can be checked against new word submissions and total points from each game. Later I can extend to fit multiple players and turn into multiplayer
+// The manager hands the same game to every request with its id, so the mutex guards the found words and total across gRPC goroutines
 type pangramGame struct {
-	letters []rune
-	center  rune
+	mu      sync.Mutex
+	board   pangram.GameBoard
 	seen    map[string]struct{}
+	found   []string
 	total   int
 	dict    dict.Repository
 	scorer  score.Scorer
+	ranks   rank.Ladder
+	maxScore int
+	created  time.Time
+	options  Options
+	deadline time.Time // zero for games without a time limit
+	ended    bool      // the players gave up, for kinds that end through Ender
+	now      func() time.Time
 }
 
 // Create the actual user game according to what is the GameBoard singleton for every game
-func NewPangramFromGameBoard(board pangram.GameBoard, repo dict.Repository, scoreStrategy score.Scorer) Game {
+func NewPangramFromGameBoard(board pangram.GameBoard, repo dict.Repository, scoreStrategy score.Scorer, ranks rank.Ladder) Game {
 	return &pangramGame{
-		letters: board.Letters,
-		center:  board.Center,
+		board:   board,
 		seen:    map[string]struct{}{},
 		dict:    repo,
 		scorer:  scoreStrategy,
+		ranks:   ranks,
+		maxScore: board.MaxScore(scoreStrategy),
+		created:  time.Now(),
 	}
 }
 
+// Create the game with the rules of its options
+func newPangram(setup Setup) *pangramGame {
+	game := NewPangramFromGameBoard(setup.Board, setup.Dict, setup.Scorer, setup.Ranks).(*pangramGame)
+	game.options = setup.Options
+	if setup.Now != nil { game.created = setup.Now() }
+	return game
+}
+
+// Rebuild a game from its snapshot, found words and total are restored as they were saved without checking the dictionary again
+func restorePangram(setup Setup, snap Snapshot) *pangramGame {
+	game := newPangram(setup)
+	for _, word := range snap.Found {
+		game.seen[word] = struct{}{}
+		game.found = append(game.found, word)
+	}
+	game.total = snap.Total
+	game.created = snap.Created
+	return game
+}
+
+// Give up the game, no more words are accepted
+func (game *pangramGame) end() {
+	game.mu.Lock()
+	defer game.mu.Unlock()
+	game.ended = true
+}
+
 func (game *pangramGame) Name() string { return "PANGRAM GAME" }
-func (game *pangramGame) Info() ([]rune, rune) { return game.letters, game.center }
+func (game *pangramGame) Info() ([]rune, rune) { return game.board.Letters, game.board.Center }
+
+// Size of the board solution, computed once from the board answers when the game is created. A game that found every answer is complete
+func (game *pangramGame) Goal() (int, int, int) { return len(game.board.Answers), len(game.board.Pangrams), game.maxScore }
+
+// Copy the game state so callers can not change the found words of the game
+func (game *pangramGame) State() State {
+	game.mu.Lock()
+	defer game.mu.Unlock()
+	return State{
+		Name: game.Name(), Letters: game.board.Letters, Center: game.board.Center,
+		Found: append([]string(nil), game.found...), Total: game.total,
+		Answers: len(game.board.Answers), Pangrams: len(game.board.Pangrams), MaxScore: game.maxScore,
+		Rank: game.standing(), Created: game.created, Options: game.options, Ended: game.ended,
+	}
+}
+
+// Snapshot of the game to be saved by the manager store
+func (game *pangramGame) Snapshot() Snapshot {
+	game.mu.Lock()
+	defer game.mu.Unlock()
+	snap := snapshotBoard(game.board)
+	snap.Found = append([]string(nil), game.found...)
+	snap.Total = game.total
+	snap.Created = game.created
+	snap.Options = game.options
+	snap.Ended = game.ended
+	return snap
+}
+
+// Take points from the total for a miss, the total never goes below zero. Returns the points taken and the standing after them
+func (game *pangramGame) penalize(points int) (int, int, rank.Standing) {
+	game.mu.Lock()
+	defer game.mu.Unlock()
+	taken := min(points, game.total)
+	game.total -= taken
+	return taken, game.total, game.standing()
+}
+
+// Rejected word response, nothing changes in the game
+func (game *pangramGame) reject(word string, reason Reason) SubmitResult {
+	return SubmitResult{Reason: reason, Word: word, Total: game.total, MaxScore: game.maxScore, Rank: game.standing()}
+}
 
 // Main functionality of the word submission. This is called inside the singleplayer pangram wrapper. I did this way so I can extend functionality for different types of games, for example, when building the multiplayer game I can call pangramMultiplayer wrapper Submit function, and inside I can call the main pangramGame Submit to validate the word and get points, but inside the Submit wrapper, I can extend functionality after calling the main game.
-func (game *pangramGame) Submit(value string) (bool, string, int, int, bool) {
+func (game *pangramGame) Submit(value string) SubmitResult {
 	value = strings.ToLower(strings.TrimSpace(value))
 
-	// Check word size rules
-	if len([]rune(value)) < 4 { return false, "TOO_SHORT", 0, game.total, false }
+	// Lock the whole submission, from the duplicate check until the word is saved, so two sessions sending the same word get one OK and one DUPLICATE
+	game.mu.Lock()
+	defer game.mu.Unlock()
+
+	// A timed game is frozen once its deadline passed, checked under the lock so no word gets in after it
+	if !game.deadline.IsZero() && !game.now().Before(game.deadline) {
+		result := game.reject(value, ReasonTimeUp)
+		result.GameOver = true
+		return result
+	}
+	if game.ended {
+		result := game.reject(value, ReasonGameOver)
+		result.GameOver = true
+		return result
+	}
+
+	// Check word size rules, the minimum length is an option of the game
+	if len([]rune(value)) < game.options.minLength() { return game.reject(value, ReasonTooShort) }
 
 	// Check if word already exists from previous submission
-	if _, isDuplicated := game.seen[value]; isDuplicated { return false, "DUPLICATE", 0, game.total, false }
+	if _, isDuplicated := game.seen[value]; isDuplicated { return game.reject(value, ReasonDuplicate) }
 
 	allowed := map[rune]struct{}{}
-	for _, chars := range game.letters { allowed[chars] = struct{}{} }
+	for _, chars := range game.board.Letters { allowed[chars] = struct{}{} }
 
 	// Check if contains the center letter and also if the letters are valid
 	hasCenter := false
 	for _, r := range value {
 		_, ok := allowed[r]
-		if !ok { return false, "INVALID_LETTER", 0, game.total, false }
-		if r == game.center { hasCenter = true }
+		if !ok { return game.reject(value, ReasonInvalidLetter) }
+		if r == game.board.Center { hasCenter = true }
 	}
-	if !hasCenter { return false, "MISSING_CENTER", 0, game.total, false }
+	if !hasCenter { return game.reject(value, ReasonMissingCenter) }
 
 	// Check if word is in the dictionary. this will first hit the cache, and inside the cache will check the repository if not presented in the cache proxy
-	ok, _ := game.dict.Has(value)
-	if !ok { return false, "NOT_IN_DICT", 0, game.total, false }
+	// A failing repository is not the player fault, so it is reported apart from NOT_IN_DICT and the word is not saved as seen
+	ok, err := game.dict.Has(value)
+	if err != nil {
+		logger.Log().Errorf("DICTIONARY LOOKUP %q: %v", value, err)
+		return game.reject(value, ReasonDictUnavailable)
+	}
+	if !ok { return game.reject(value, ReasonNotInDict) }
 
 	// check if length of the word is the same from the real pangram
 	isSeenMap := map[rune]struct{}{}
@@ -66,12 +171,31 @@ func (game *pangramGame) Submit(value string) (bool, string, int, int, bool) {
 	// Score point according to its size. Remember the Score here is a Strategy pattern so whatever strategy we pass as a dependency injection, will represent the Score function. In this case, we inject a BonusStrategy that will take the BasicScorer and either return 1, 0 or the length of the word, and will sum up with whatever value is the bonus (+7)
 	pts := game.scorer.Score(len(value), pangram)
 
-	// Save game total points
+	// Save game total points, and check if the word moved the player up the rank ladder
+	before := game.standing()
 	game.total += pts
 
-	// Save word as seen
+	// Save word as seen, and keep the order words were found
 	game.seen[value] = struct{}{}
+	game.found = append(game.found, value)
+	after := game.standing()
 
 	// Return word response.
-	return true, "OK", pts, game.total, pangram
+	return SubmitResult{
+		Valid: true, Reason: ReasonOK, Word: value, Points: pts, Total: game.total, MaxScore: game.maxScore,
+		Pangram: pangram, BoardPangram: value == game.board.Word, Perfect: pangram && len([]rune(value)) == len(allowed),
+		Rank: after, RankUp: after.Level > before.Level,
+		Complete: game.complete(),
+	}
+}
+
+// Every answer of the board was found. Counted on words and not on points, so miss penalties and race bonuses do not change it. The caller holds the lock
+func (game *pangramGame) complete() bool {
+	return len(game.board.Answers) > 0 && len(game.found) == len(game.board.Answers)
+}
+
+// Rank of the total, a game that found every answer is at the top of the ladder even when penalties took points from it. The caller holds the lock
+func (game *pangramGame) standing() rank.Standing {
+	if game.complete() { return game.ranks.At(game.maxScore, game.maxScore) }
+	return game.ranks.At(game.total, game.maxScore)
 }
@@ -0,0 +1,70 @@
+package games
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+// Blitz wrapper over the pangramGame. The player has a time budget from the moment the game is created, after it every word is rejected with TIME_UP and the score is final.
+// The deadline is checked here with the clock of the setup, so clients can not extend it and tests can move the time
+type pangramBlitz struct {
+	core *pangramGame
+	now  func() time.Time
+}
+
+const (
+	blitz            = "blitz"
+	defaultBlitzTime = 3 * time.Minute
+)
+
+func init() {
+	Register(Kind{
+		Name:        blitz,
+		Description: "Find as many words as you can before the time runs out",
+		Options: append(slices.Clone(boardOptions),
+			OptionSpec{Name: OptionTimeLimit, Type: "duration", Default: defaultBlitzTime.String(), Description: "time to play, e.g. 60s or 3m"},
+		),
+		New: func(setup Setup) (Game, error) {
+			if setup.Options.TimeLimit == 0 { setup.Options.TimeLimit = defaultBlitzTime }
+			return newBlitz(newPangram(setup), setup), nil
+		},
+		Restore: func(setup Setup, snap Snapshot) (Game, error) {
+			if setup.Options.TimeLimit == 0 { setup.Options.TimeLimit = defaultBlitzTime }
+			return newBlitz(restorePangram(setup, snap), setup), nil
+		},
+	})
+}
+
+func newBlitz(core *pangramGame, setup Setup) *pangramBlitz {
+	now := setup.Now
+	if now == nil { now = time.Now }
+	core.now = now
+	core.deadline = core.created.Add(setup.Options.TimeLimit)
+	return &pangramBlitz{core: core, now: now}
+}
+
+// Implementing Game interface
+func (game *pangramBlitz) Name() string { return fmt.Sprintf("%s - %s", game.core.Name(), "BLITZ") }
+func (game *pangramBlitz) Info() ([]rune, rune) { return game.core.Info() }
+func (game *pangramBlitz) Goal() (int, int, int) { return game.core.Goal() }
+
+func (game *pangramBlitz) State() State {
+	state := game.core.State()
+	state.Name = game.Name()
+	state.EndsAt = game.core.deadline
+	state.Ended = game.timeUp()
+	return state
+}
+
+func (game *pangramBlitz) Snapshot() Snapshot {
+	snap := game.core.Snapshot()
+	snap.Kind = blitz
+	snap.Ended = game.timeUp()
+	return snap
+}
+
+// Words sent after the deadline are rejected by the core game, so the game stays as it was when the time ran out
+func (game *pangramBlitz) Submit(word string) SubmitResult { return game.core.Submit(word) }
+
+func (game *pangramBlitz) timeUp() bool { return !game.now().Before(game.core.deadline) }
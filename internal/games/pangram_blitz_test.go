@@ -0,0 +1,32 @@
+package games_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/luispellizzon/pangram/internal/games"
+)
+
+// The deadline is enforced with the clock of the factory, so moving it past the time limit ends the game without waiting
+func TestBlitzTimeUp(t *testing.T) {
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	factory := newFactory(now)
+	factory.Now = func() time.Time { return now }
+	game, err := factory.New("blitz", games.Options{TimeLimit: time.Minute})
+	if err != nil { t.Fatalf("New: %v", err) }
+
+	if result := game.Submit("alarm"); !result.Valid { t.Fatalf("Submit before the deadline = %v, want OK", result.Reason) }
+	state := game.State()
+	if state.Ended { t.Fatalf("game ended before its deadline") }
+	if want := now.Add(time.Minute); !state.EndsAt.Equal(want) { t.Fatalf("EndsAt = %v, want %v", state.EndsAt, want) }
+	total := state.Total
+
+	now = now.Add(time.Minute)
+	result := game.Submit("moral")
+	if result.Valid || result.Reason != games.ReasonTimeUp || !result.GameOver { t.Fatalf("Submit at the deadline = %v GameOver %v, want TIME_UP and GameOver", result.Reason, result.GameOver) }
+	if result.Total != total { t.Fatalf("Submit after the deadline changed the total to %d, want %d", result.Total, total) }
+	state = game.State()
+	if !state.Ended { t.Fatalf("State().Ended = false after the deadline") }
+	if state.Total != total || len(state.Found) != 1 { t.Fatalf("state after the deadline has %v found and %d points, want [alarm] and %d", state.Found, state.Total, total) }
+	if !game.Snapshot().Ended { t.Fatalf("Snapshot().Ended = false after the deadline") }
+}
@@ -0,0 +1,94 @@
+package games
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/luispellizzon/pangram/internal/score"
+)
+
+// Hardcore wrapper over the pangramGame. A word that is not in the dictionary, uses a letter off the board or misses the center letter costs a life, and the game ends when no lives are left.
+// With a miss penalty the scorer of the game is a score.PenaltyScorer, and every miss also takes its points from the total
+type pangramHardcore struct {
+	core   *pangramGame
+	mu     sync.Mutex
+	lives  int
+	misses int
+}
+
+const (
+	hardcore     = "hardcore"
+	defaultLives = 3
+)
+
+func init() {
+	Register(Kind{
+		Name:        hardcore,
+		Description: "Every word that is not on the board costs a life, the game ends when the lives run out",
+		Options: append(slices.Clone(boardOptions),
+			OptionSpec{Name: OptionLives, Type: "int", Default: fmt.Sprint(defaultLives), Description: "misses allowed before the game ends"},
+			OptionSpec{Name: OptionMissPenalty, Type: "int", Description: "points taken for every miss"},
+		),
+		New: func(setup Setup) (Game, error) {
+			if setup.Options.Lives == 0 { setup.Options.Lives = defaultLives }
+			return &pangramHardcore{core: newPangram(setup), lives: setup.Options.Lives}, nil
+		},
+		Restore: func(setup Setup, snap Snapshot) (Game, error) {
+			if setup.Options.Lives == 0 { setup.Options.Lives = defaultLives }
+			return &pangramHardcore{core: restorePangram(setup, snap), lives: max(setup.Options.Lives-snap.Misses, 0), misses: snap.Misses}, nil
+		},
+	})
+}
+
+// Words that cost a life, a short or repeated word is a slip and not a miss
+func missed(reason Reason) bool {
+	return reason == ReasonNotInDict || reason == ReasonInvalidLetter || reason == ReasonMissingCenter
+}
+
+// Implementing Game interface
+func (game *pangramHardcore) Name() string { return fmt.Sprintf("%s - %s", game.core.Name(), "HARDCORE") }
+func (game *pangramHardcore) Info() ([]rune, rune) { return game.core.Info() }
+func (game *pangramHardcore) Goal() (int, int, int) { return game.core.Goal() }
+
+func (game *pangramHardcore) State() State {
+	game.mu.Lock()
+	defer game.mu.Unlock()
+	state := game.core.State()
+	state.Name = game.Name()
+	state.Lives = game.lives
+	state.Ended = game.lives == 0
+	return state
+}
+
+func (game *pangramHardcore) Snapshot() Snapshot {
+	game.mu.Lock()
+	defer game.mu.Unlock()
+	snap := game.core.Snapshot()
+	snap.Kind = hardcore
+	snap.Misses = game.misses
+	snap.Ended = game.lives == 0
+	return snap
+}
+
+// The wrapper lock keeps the lives in step with the core, so two misses sent at once can not both spend the last life
+func (game *pangramHardcore) Submit(word string) SubmitResult {
+	game.mu.Lock()
+	defer game.mu.Unlock()
+	if game.lives == 0 {
+		state := game.core.State()
+		return SubmitResult{Reason: ReasonGameOver, Word: word, Total: state.Total, MaxScore: state.MaxScore, Rank: state.Rank, GameOver: true}
+	}
+	result := game.core.Submit(word)
+	if missed(result.Reason) {
+		game.lives--
+		game.misses++
+		result.LostLife = true
+		if penalty, ok := game.core.scorer.(score.Penalizer); ok && penalty.Miss() > 0 {
+			result.Penalty, result.Total, result.Rank = game.core.penalize(penalty.Miss())
+		}
+	}
+	result.Lives = game.lives
+	result.GameOver = game.lives == 0
+	return result
+}
@@ -0,0 +1,154 @@
+package games
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// Co-op multiplayer wrapper over the pangramGame. Every player plays the same hive: the found words and the total are shared, and the wrapper remembers who found each word first
+type pangramCoop struct {
+	core    *pangramGame
+	mu      sync.Mutex
+	players []*Player
+	byName  map[string]*Player
+}
+
+const (
+	multiplayer = "multiplayer"
+	MaxPlayers  = 8
+	maxName     = 20
+)
+
+func init() {
+	Register(Kind{
+		Name:        multiplayer,
+		Description: "Play one board with friends, every word found counts for the whole team",
+		Options: boardOptions,
+		Players: true,
+		New: func(setup Setup) (Game, error) {
+			return &pangramCoop{core: newPangram(setup), byName: map[string]*Player{}}, nil
+		},
+		Restore: func(setup Setup, snap Snapshot) (Game, error) {
+			game := &pangramCoop{core: restorePangram(setup, snap), byName: map[string]*Player{}}
+			game.core.ended = snap.Ended
+			for _, saved := range snap.Players {
+				player := &Player{Name: saved.Name, Found: append([]string(nil), saved.Found...), Points: saved.Points, Left: saved.Left}
+				game.players = append(game.players, player)
+				game.byName[strings.ToLower(player.Name)] = player
+			}
+			return game, nil
+		},
+	})
+}
+
+// Check a player name, names are compared without case so "Ana" and "ana" can not both join
+func playerName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" { return "", fmt.Errorf("%w: name is required", ErrBadPlayer) }
+	if len([]rune(name)) > maxName { return "", fmt.Errorf("%w: %q is longer than %d letters", ErrBadPlayer, name, maxName) }
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != ' ' && r != '-' && r != '_' {
+			return "", fmt.Errorf("%w: %q can only have letters, digits, spaces, - and _", ErrBadPlayer, name)
+		}
+	}
+	return name, nil
+}
+
+// Implementing Game interface
+func (game *pangramCoop) Name() string { return fmt.Sprintf("%s - %s", game.core.Name(), "CO-OP") }
+func (game *pangramCoop) Info() ([]rune, rune) { return game.core.Info() }
+func (game *pangramCoop) Goal() (int, int, int) { return game.core.Goal() }
+
+func (game *pangramCoop) State() State {
+	game.mu.Lock()
+	defer game.mu.Unlock()
+	state := game.core.State()
+	state.Name = game.Name()
+	state.Players = game.copyPlayers()
+	return state
+}
+
+func (game *pangramCoop) Snapshot() Snapshot {
+	game.mu.Lock()
+	defer game.mu.Unlock()
+	snap := game.core.Snapshot()
+	snap.Kind = multiplayer
+	snap.Players = game.copyPlayers()
+	return snap
+}
+
+// Submit plays as the player that created the game
+func (game *pangramCoop) Submit(word string) SubmitResult {
+	game.mu.Lock()
+	first := ""
+	if len(game.players) > 0 { first = game.players[0].Name }
+	game.mu.Unlock()
+	return game.SubmitAs(first, word)
+}
+
+// Implementing WithPlayers interface
+func (game *pangramCoop) Join(name string) (string, error) {
+	name, err := playerName(name)
+	if err != nil { return "", err }
+	game.mu.Lock()
+	defer game.mu.Unlock()
+	if player, taken := game.byName[strings.ToLower(name)]; taken {
+		if !player.Left { return "", fmt.Errorf("%w: %s", ErrPlayerTaken, name) }
+		player.Left = false
+		return player.Name, nil
+	}
+	if len(game.players) >= MaxPlayers { return "", fmt.Errorf("%w: %d players", ErrGameFull, MaxPlayers) }
+	player := &Player{Name: name, Found: []string{}}
+	game.players = append(game.players, player)
+	game.byName[strings.ToLower(name)] = player
+	return name, nil
+}
+
+// The core game checks the word against the shared found list, so a word found by a team mate is a DUPLICATE for everyone. The wrapper lock keeps the finder in step with the core
+func (game *pangramCoop) SubmitAs(name string, word string) SubmitResult {
+	game.mu.Lock()
+	defer game.mu.Unlock()
+	player, ok := game.byName[strings.ToLower(name)]
+	if !ok || player.Left { return SubmitResult{Reason: ReasonError, Word: word, Player: name} }
+	result := game.core.Submit(word)
+	if result.Valid {
+		player.Found = append(player.Found, result.Word)
+		player.Points += result.Points
+	}
+	result.Player = player.Name
+	result.PlayerPoints = player.Points
+	return result
+}
+
+// Implementing Ender interface, the team gives up together
+func (game *pangramCoop) End() { game.core.end() }
+
+// The words and points of a player that leaves stay with the team
+func (game *pangramCoop) Leave(name string) error {
+	game.mu.Lock()
+	defer game.mu.Unlock()
+	player, ok := game.byName[strings.ToLower(name)]
+	if !ok || player.Left { return fmt.Errorf("%w: %s", ErrNoPlayer, name) }
+	player.Left = true
+	return nil
+}
+
+// Every player sees the same hive
+func (game *pangramCoop) StateAs(string) State { return game.State() }
+
+func (game *pangramCoop) Players() []Player {
+	game.mu.Lock()
+	defer game.mu.Unlock()
+	return game.copyPlayers()
+}
+
+// Copy the players so callers can not change the game, the caller holds the lock
+func (game *pangramCoop) copyPlayers() []Player {
+	players := make([]Player, 0, len(game.players))
+	for _, player := range game.players {
+		players = append(players, Player{Name: player.Name, Found: append([]string{}, player.Found...), Words: len(player.Found), Points: player.Points, Left: player.Left})
+	}
+	return players
+}
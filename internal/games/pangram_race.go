@@ -0,0 +1,237 @@
+package games
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Race wrapper. Every player gets a pangramSingle of their own over the same board, so found words and scores are kept apart, and the race decides who wins.
+// The first player to find a word can get a first-finder bonus on top of the word points
+type pangramRace struct {
+	setup   Setup
+	mu      sync.Mutex
+	racers  []*racer
+	byName  map[string]*racer
+	firstBy map[string]string
+	created time.Time
+	ended   bool
+	winner  string
+}
+
+type racer struct {
+	name  string
+	game  *pangramSingle
+	bonus int
+	left  bool
+}
+
+const race = "race"
+
+func init() {
+	Register(Kind{
+		Name:        race,
+		Description: "Race friends on the same board, each player scores on their own and the best score wins",
+		Options: append(slices.Clone(boardOptions),
+			OptionSpec{Name: OptionTimeLimit, Type: "duration", Description: "the best score when the time runs out wins"},
+			OptionSpec{Name: OptionTargetScore, Type: "int", Description: "the first player to reach this score wins"},
+			OptionSpec{Name: OptionFirstBonus, Type: "int", Description: "extra points for the first player to find a word"},
+		),
+		Players: true,
+		New: func(setup Setup) (Game, error) {
+			if setup.Options.TimeLimit == 0 && setup.Options.TargetScore == 0 {
+				return nil, invalidOption(OptionTimeLimit, "a race needs a time limit or a target score")
+			}
+			return &pangramRace{setup: setup, byName: map[string]*racer{}, firstBy: map[string]string{}, created: setup.Now()}, nil
+		},
+		Restore: func(setup Setup, snap Snapshot) (Game, error) {
+			game := &pangramRace{setup: setup, byName: map[string]*racer{}, firstBy: map[string]string{}, created: snap.Created, ended: snap.Ended, winner: snap.Winner}
+			for word, name := range snap.FirstBy { game.firstBy[word] = name }
+			for _, saved := range snap.Players {
+				core := restorePangram(setup, Snapshot{Found: saved.Found, Total: saved.Points - saved.Bonus, Created: snap.Created})
+				game.add(&racer{name: saved.Name, game: &pangramSingle{core: core}, bonus: saved.Bonus, left: saved.Left})
+			}
+			return game, nil
+		},
+	})
+}
+
+func (game *pangramRace) add(player *racer) {
+	game.racers = append(game.racers, player)
+	game.byName[strings.ToLower(player.name)] = player
+}
+
+// Implementing Game interface
+func (game *pangramRace) Name() string { return "PANGRAM GAME - RACE" }
+func (game *pangramRace) Info() ([]rune, rune) { return game.setup.Board.Letters, game.setup.Board.Center }
+func (game *pangramRace) Goal() (int, int, int) {
+	return len(game.setup.Board.Answers), len(game.setup.Board.Pangrams), game.setup.Board.MaxScore(game.setup.Scorer)
+}
+
+// Spectators see the standings, but no player words until the race ends
+func (game *pangramRace) State() State { return game.StateAs("") }
+
+func (game *pangramRace) Snapshot() Snapshot {
+	game.mu.Lock()
+	defer game.mu.Unlock()
+	snap := snapshotBoard(game.setup.Board)
+	snap.Kind = race
+	snap.Created = game.created
+	snap.Options = game.setup.Options
+	snap.Players = game.players("", true)
+	snap.FirstBy = map[string]string{}
+	for word, name := range game.firstBy { snap.FirstBy[word] = name }
+	snap.Ended = game.ended
+	snap.Winner = game.winner
+	return snap
+}
+
+// Submit plays as the player that created the game
+func (game *pangramRace) Submit(word string) SubmitResult {
+	game.mu.Lock()
+	first := ""
+	if len(game.racers) > 0 { first = game.racers[0].name }
+	game.mu.Unlock()
+	return game.SubmitAs(first, word)
+}
+
+// Implementing WithPlayers interface
+func (game *pangramRace) Join(name string) (string, error) {
+	name, err := playerName(name)
+	if err != nil { return "", err }
+	game.mu.Lock()
+	defer game.mu.Unlock()
+	if game.over() { return "", ErrGameOver }
+	if player, taken := game.byName[strings.ToLower(name)]; taken {
+		if !player.left { return "", fmt.Errorf("%w: %s", ErrPlayerTaken, name) }
+		player.left = false
+		return player.name, nil
+	}
+	if len(game.racers) >= MaxPlayers { return "", fmt.Errorf("%w: %d players", ErrGameFull, MaxPlayers) }
+	game.add(&racer{name: name, game: &pangramSingle{core: newPangram(game.setup)}})
+	return name, nil
+}
+
+// Each word is checked by the player own game, so a word found by an opponent still scores. The race lock keeps the first finder and the winner in step with the players games
+func (game *pangramRace) SubmitAs(name string, word string) SubmitResult {
+	game.mu.Lock()
+	defer game.mu.Unlock()
+	player, ok := game.byName[strings.ToLower(name)]
+	if !ok || player.left { return SubmitResult{Reason: ReasonError, Word: word, Player: name} }
+	if game.over() {
+		state := player.game.State()
+		reason := ReasonGameOver
+		if deadline := game.endsAt(); !deadline.IsZero() && !game.setup.Now().Before(deadline) { reason = ReasonTimeUp }
+		return game.finish(player, SubmitResult{Reason: reason, Word: word, Total: state.Total, MaxScore: state.MaxScore})
+	}
+	result := player.game.Submit(word)
+	if result.Valid {
+		if _, taken := game.firstBy[result.Word]; !taken {
+			game.firstBy[result.Word] = player.name
+			player.bonus += game.setup.Options.FirstBonus
+			result.FirstBonus = game.setup.Options.FirstBonus
+			result.Points += result.FirstBonus
+		}
+		if target := game.setup.Options.TargetScore; target > 0 && result.Total+player.bonus >= target {
+			game.ended = true
+			game.winner = player.name
+		}
+	}
+	return game.finish(player, result)
+}
+
+// Fill the race fields of a result, the caller holds the lock
+func (game *pangramRace) finish(player *racer, result SubmitResult) SubmitResult {
+	result.Total += player.bonus
+	// the rank counts the first-finder points too
+	ranks := game.setup.Ranks
+	result.Rank = ranks.At(result.Total, result.MaxScore)
+	if result.Valid { result.RankUp = result.Rank.Level > ranks.At(result.Total-result.Points, result.MaxScore).Level }
+	result.Player = player.name
+	result.PlayerPoints = result.Total
+	result.GameOver = game.ended
+	result.Winner = game.winner
+	return result
+}
+
+// The game of a player, with the race standings. Before the end only the player own words are listed
+func (game *pangramRace) StateAs(name string) State {
+	game.mu.Lock()
+	defer game.mu.Unlock()
+	game.over()
+	state := State{
+		Letters: game.setup.Board.Letters, Center: game.setup.Board.Center, Found: []string{},
+		Answers: len(game.setup.Board.Answers), Pangrams: len(game.setup.Board.Pangrams), MaxScore: game.setup.Board.MaxScore(game.setup.Scorer),
+		Created: game.created, Options: game.setup.Options, Ended: game.ended, Winner: game.winner, EndsAt: game.endsAt(),
+	}
+	if player, ok := game.byName[strings.ToLower(name)]; ok {
+		own := player.game.State()
+		state.Found, state.Total = own.Found, own.Total+player.bonus
+	}
+	state.Rank = game.setup.Ranks.At(state.Total, state.MaxScore)
+	state.Name = game.Name()
+	state.Players = game.standings(name, game.ended)
+	return state
+}
+
+// A player that leaves keeps the score in the standings and can still win
+func (game *pangramRace) Leave(name string) error {
+	game.mu.Lock()
+	defer game.mu.Unlock()
+	player, ok := game.byName[strings.ToLower(name)]
+	if !ok || player.left { return fmt.Errorf("%w: %s", ErrNoPlayer, name) }
+	player.left = true
+	return nil
+}
+
+// Words stay secret until the race ends
+func (game *pangramRace) Secret() bool {
+	game.mu.Lock()
+	defer game.mu.Unlock()
+	return !game.over()
+}
+
+// Standings of the race, the words of every player stay hidden until it ends
+func (game *pangramRace) Players() []Player {
+	game.mu.Lock()
+	defer game.mu.Unlock()
+	return game.standings("", game.over())
+}
+
+// Players ordered by points, ties keep the order they joined
+func (game *pangramRace) standings(viewer string, reveal bool) []Player {
+	players := game.players(viewer, reveal)
+	sort.SliceStable(players, func(i, j int) bool { return players[i].Points > players[j].Points })
+	return players
+}
+
+// Players in the order they joined. Words of the other players are only shown when reveal is set, the caller holds the lock
+func (game *pangramRace) players(viewer string, reveal bool) []Player {
+	players := make([]Player, 0, len(game.racers))
+	for _, player := range game.racers {
+		state := player.game.State()
+		standing := Player{Name: player.name, Found: []string{}, Words: len(state.Found), Points: state.Total + player.bonus, Bonus: player.bonus, Left: player.left}
+		if reveal || strings.EqualFold(player.name, viewer) { standing.Found = state.Found }
+		players = append(players, standing)
+	}
+	return players
+}
+
+func (game *pangramRace) endsAt() time.Time {
+	if game.setup.Options.TimeLimit == 0 { return time.Time{} }
+	return game.created.Add(game.setup.Options.TimeLimit)
+}
+
+// End the race when its time ran out. The best score wins, a tie at the top has no winner. The caller holds the lock
+func (game *pangramRace) over() bool {
+	if game.ended { return true }
+	deadline := game.endsAt()
+	if deadline.IsZero() || game.setup.Now().Before(deadline) { return false }
+	game.ended = true
+	players := game.standings("", true)
+	if len(players) == 1 || (len(players) > 1 && players[0].Points > players[1].Points) { game.winner = players[0].Name }
+	return true
+}
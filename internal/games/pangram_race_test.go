@@ -0,0 +1,28 @@
+package games_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/luispellizzon/pangram/internal/games"
+)
+
+// Players is public API, it must not show the words of the racers while the race is played
+func TestRacePlayersHidden(t *testing.T) {
+	game, err := newFactory(time.Now()).New("race", games.Options{TargetScore: 10})
+	if err != nil { t.Fatalf("New: %v", err) }
+	race := game.(games.WithPlayers)
+	for _, name := range []string{"Ana", "Bea"} {
+		if _, err := race.Join(name); err != nil { t.Fatalf("Join %s: %v", name, err) }
+	}
+	if result := race.SubmitAs("Ana", "alarm"); !result.Valid { t.Fatalf("SubmitAs = %v, want OK", result.Reason) }
+	for _, player := range race.Players() {
+		if len(player.Found) != 0 { t.Fatalf("Players shows the words of %s while the race is played: %v", player.Name, player.Found) }
+		if player.Name == "Ana" && player.Words != 1 { t.Fatalf("Players counts %d words for Ana, want 1", player.Words) }
+	}
+
+	if result := race.SubmitAs("Ana", "formally"); !result.GameOver { t.Fatalf("race did not end at the target score") }
+	for _, player := range race.Players() {
+		if player.Name == "Ana" && len(player.Found) != 2 { t.Fatalf("Players shows %v for Ana after the race, want 2 words", player.Found) }
+	}
+}
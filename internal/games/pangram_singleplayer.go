@@ -5,21 +5,58 @@ import (
 
 	"github.com/luispellizzon/pangram/internal/dict"
 	"github.com/luispellizzon/pangram/internal/pangram"
+	"github.com/luispellizzon/pangram/internal/rank"
 	"github.com/luispellizzon/pangram/internal/score"
 )
 
 // Single-player wrapper over the pangramGame itself
 type pangramSingle struct{ core Game }
 
+const singleplayer = "singleplayer"
+
+func init() {
+	Register(Kind{
+		Name:        singleplayer,
+		Description: "Play the daily board on your own",
+		Options: boardOptions,
+		New: func(setup Setup) (Game, error) {
+			return &pangramSingle{core: newPangram(setup)}, nil
+		},
+		Restore: func(setup Setup, snap Snapshot) (Game, error) {
+			core := restorePangram(setup, snap)
+			core.ended = snap.Ended
+			return &pangramSingle{core: core}, nil
+		},
+	})
+}
+
 // Return a new Game instance
-func NewPangramSingle(board pangram.GameBoard, repo dict.Repository, scorer score.Scorer) Game {
-	return &pangramSingle{core: NewPangramFromGameBoard(board, repo, scorer)}
+func NewPangramSingle(board pangram.GameBoard, repo dict.Repository, scorer score.Scorer, ranks rank.Ladder) Game {
+	return &pangramSingle{core: NewPangramFromGameBoard(board, repo, scorer, ranks)}
 }
 
 // Implementing Game interface
 func (game *pangramSingle) Name() string { return fmt.Sprintf("%s - %s", game.core.Name(), "SINGLE PLAYER") }
 func (game *pangramSingle) Info() ([]rune, rune) { return game.core.Info() }
+func (game *pangramSingle) Goal() (int, int, int) { return game.core.Goal() }
+
+func (game *pangramSingle) State() State {
+	state := game.core.State()
+	state.Name = game.Name()
+	return state
+}
 
-func (game *pangramSingle) Submit(word string) (bool, string, int, int, bool) {
+func (game *pangramSingle) Snapshot() Snapshot {
+	snap := game.core.Snapshot()
+	snap.Kind = singleplayer
+	return snap
+}
+
+func (game *pangramSingle) Submit(word string) SubmitResult {
 	return game.core.Submit(word)
 }
+
+// Implementing Ender interface
+func (game *pangramSingle) End() {
+	if core, ok := game.core.(*pangramGame); ok { core.end() }
+}
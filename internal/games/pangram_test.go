@@ -0,0 +1,145 @@
+package games_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/luispellizzon/pangram/internal/games"
+	"github.com/luispellizzon/pangram/internal/manager"
+	"github.com/luispellizzon/pangram/internal/pangram"
+	"github.com/luispellizzon/pangram/internal/rank"
+)
+
+// Stub dictionary, only the words of the test board exist
+type words map[string]struct{}
+
+func (w words) Has(word string) (bool, error) { _, ok := w[word]; return ok, nil }
+
+// Stub provider, every board option gives the same board
+type boards struct{ board pangram.GameBoard }
+
+func (b boards) Board() (pangram.GameBoard, error) { return b.board, nil }
+func (b boards) BoardFor(string) (pangram.GameBoard, error) { return b.board, nil }
+func (b boards) Puzzle(int) (pangram.GameBoard, error) { return b.board, nil }
+func (b boards) Random() (pangram.GameBoard, error) { return b.board, nil }
+
+const (
+	submitters = 64
+	reads      = 20 // rounds of reads of each reader
+)
+
+func newManager(t *testing.T) manager.Manager { return newManagerOn(t, time.Now(), manager.NewMemoryStore()) }
+
+// Factory whose every board is dated day
+func newFactory(day time.Time) *games.Factory {
+	board := pangram.GameBoard{
+		Letters: []rune("formyla"), Center: 'm', Word: "formally", Date: day,
+		Answers: []string{"alarm", "formally", "moral"}, Pangrams: []string{"formally"},
+	}
+	return &games.Factory{Dict: words{"alarm": {}, "formally": {}, "moral": {}}, Board: boards{board}, Ranks: rank.Default()}
+}
+
+// Manager whose every board is dated day
+func newManagerOn(t *testing.T, day time.Time, store manager.Store) manager.Manager {
+	t.Helper()
+	m, err := manager.New(newFactory(day), store, manager.Config{})
+	if err != nil { t.Fatalf("manager.New: %v", err) }
+	t.Cleanup(m.Close)
+	return m
+}
+
+// Every goroutine gets its own handle from the manager and sends the same word at the same time, only one handle of each player can find it.
+// Readers call State, Snapshot, Info and Players on their own handles while the words are played.
+// Run with go test -race so the locks of the game and of the manager wrappers are checked too, on the read paths and on the snapshot saved after every word
+func submitTogether(t *testing.T, m manager.Manager, id string, tokens []string, want int) {
+	t.Helper()
+	start := make(chan struct{})
+	results := make([]games.SubmitResult, len(tokens))
+	var wg sync.WaitGroup
+	for i, token := range tokens {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			game, _, err := m.Get(id, token, manager.Play)
+			if err != nil { t.Errorf("Get: %v", err); return }
+			<-start
+			results[i] = game.Submit("  ALARM ")
+		}()
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			game, _, err := m.Get(id, token, manager.Play)
+			if err != nil { t.Errorf("Get: %v", err); return }
+			<-start
+			for range reads {
+				game.State()
+				game.Snapshot()
+				game.Info()
+				if players, ok := game.(games.WithPlayers); ok { players.Players() }
+			}
+		}()
+	}
+	close(start)
+	wg.Wait()
+
+	// want OKs, one for the game or one for each player of a race, and every other handle gets DUPLICATE
+	counts, points := map[games.Reason]int{}, map[string]int{}
+	for _, result := range results {
+		counts[result.Reason]++
+		if result.Valid { points[result.Player] += result.Points }
+	}
+	if counts[games.ReasonOK] != want || len(points) != want { t.Fatalf("%d submissions were OK by %d players, want %d (%v)", counts[games.ReasonOK], len(points), want, counts) }
+	if counts[games.ReasonDuplicate] != len(tokens)-want { t.Fatalf("%d submissions were DUPLICATE, want %d (%v)", counts[games.ReasonDuplicate], len(tokens)-want, counts) }
+
+	game, _, err := m.Get(id, tokens[0], manager.Play)
+	if err != nil { t.Fatalf("Get: %v", err) }
+	total := 0
+	for _, p := range points { total = p }
+	if want > 1 { total = points[manager.PlayerOf(game)] }
+	if state := game.State(); len(state.Found) != 1 || state.Total != total { t.Fatalf("state has %v found and %d points, want [alarm] and %d", state.Found, state.Total, total) }
+}
+
+func TestConcurrentSubmitSingleplayer(t *testing.T) {
+	m := newManager(t)
+	id, _, tokens, err := m.Create("singleplayer", games.Options{}, "", false)
+	if err != nil { t.Fatalf("Create: %v", err) }
+	same := make([]string, submitters)
+	for i := range same { same[i] = tokens.Player }
+	submitTogether(t, m, id, same, 1)
+}
+
+func TestConcurrentSubmitMultiplayer(t *testing.T) {
+	m := newManager(t)
+	id, seats := seated(t, m, "multiplayer", games.Options{})
+	submitTogether(t, m, id, spread(seats), 1)
+}
+
+// In a race every player plays their own words, so each of them finds the word once
+func TestConcurrentSubmitRace(t *testing.T) {
+	m := newManager(t)
+	id, seats := seated(t, m, "race", games.Options{TargetScore: 100})
+	submitTogether(t, m, id, spread(seats), len(seats))
+}
+
+// Create a game of kind and fill it with players, returns the token of each player
+func seated(t *testing.T, m manager.Manager, kind string, options games.Options) (string, []string) {
+	t.Helper()
+	id, _, tokens, err := m.Create(kind, options, "host", false)
+	if err != nil { t.Fatalf("Create: %v", err) }
+	seats := []string{tokens.Player}
+	for i := 1; i < games.MaxPlayers; i++ {
+		seat, _, err := m.Join(id, tokens.Invite, fmt.Sprintf("player %d", i))
+		if err != nil { t.Fatalf("Join: %v", err) }
+		seats = append(seats, seat)
+	}
+	return id, seats
+}
+
+// every player sends the word from several handles at once
+func spread(seats []string) []string {
+	all := make([]string, 0, submitters)
+	for i := 0; i < submitters; i++ { all = append(all, seats[i%len(seats)]) }
+	return all
+}
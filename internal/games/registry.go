@@ -0,0 +1,96 @@
+package games
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/luispellizzon/pangram/internal/dict"
+	"github.com/luispellizzon/pangram/internal/pangram"
+	"github.com/luispellizzon/pangram/internal/rank"
+	"github.com/luispellizzon/pangram/internal/score"
+)
+
+// Setup is what the factory hands to a kind constructor: the board to play, the server dependencies and the game options. The scorer is already built from the options
+type Setup struct {
+	Board   pangram.GameBoard
+	Dict    dict.Repository
+	Scorer  score.Scorer
+	Ranks   rank.Ladder
+	Options Options
+	Now     func() time.Time // clock of the games with a time limit
+}
+
+// OptionSpec describes one option a game kind accepts, so clients can show it without knowing the kind
+type OptionSpec struct {
+	Name        string
+	Type        string // int, string, bool or duration
+	Default     string
+	Description string
+}
+
+// Options every kind takes: the rules of the words and the board to play. Kinds append their own options to a copy
+var boardOptions = []OptionSpec{
+	{Name: OptionMinLength, Type: "int", Description: "shortest word accepted"},
+	{Name: OptionScoring, Type: "string", Description: "basic or bonus"},
+	{Name: OptionPangramBonus, Type: "int", Description: "extra points for a pangram with bonus scoring"},
+	{Name: OptionBoard, Type: "string", Description: "daily, random or a past date YYYY-MM-DD"},
+	{Name: OptionPuzzle, Type: "int", Description: "puzzle number of a past board, 1 is the first puzzle"},
+}
+
+// Kind is a game variant the factory can build. A kind without New is listed but can not be created yet
+type Kind struct {
+	Name        string
+	Description string
+	Options     []OptionSpec
+	Players     bool // played by named players that join the game, see WithPlayers
+	New         func(setup Setup) (Game, error)
+	Restore     func(setup Setup, snap Snapshot) (Game, error)
+}
+
+func (k Kind) Available() bool { return k.New != nil }
+
+// Registry of game kinds. New variants register themselves, so neither the factory nor the clients need to change to offer them
+type Registry struct {
+	mu    sync.RWMutex
+	kinds map[string]Kind
+	order []string
+}
+
+func NewRegistry() *Registry { return &Registry{kinds: map[string]Kind{}} }
+
+func (r *Registry) Register(kind Kind) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if kind.Name == "" { return fmt.Errorf("GAME KIND HAS NO NAME") }
+	if _, ok := r.kinds[kind.Name]; ok { return fmt.Errorf("GAME KIND %q ALREADY REGISTERED", kind.Name) }
+	r.kinds[kind.Name] = kind
+	r.order = append(r.order, kind.Name)
+	return nil
+}
+
+func (r *Registry) Lookup(name string) (Kind, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	kind, ok := r.kinds[name]
+	return kind, ok
+}
+
+// Kinds in the order they were registered
+func (r *Registry) Kinds() []Kind {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	kinds := make([]Kind, 0, len(r.order))
+	for _, name := range r.order { kinds = append(kinds, r.kinds[name]) }
+	return kinds
+}
+
+// Registry used by factories without their own, game kinds of this package add themselves in init
+var defaultRegistry = NewRegistry()
+
+func DefaultRegistry() *Registry { return defaultRegistry }
+
+// Register a kind in the default registry. Registering twice is a programming error
+func Register(kind Kind) {
+	if err := defaultRegistry.Register(kind); err != nil { panic(err) }
+}
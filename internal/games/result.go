@@ -0,0 +1,60 @@
+package games
+
+import (
+	"github.com/luispellizzon/pangram/internal/rank"
+)
+
+// Reason is why a submitted word was accepted or rejected. The values follow the WordResult enum of the gRPC API, so the server maps them one to one
+type Reason int32
+
+const (
+	ReasonError Reason = iota
+	ReasonOK
+	ReasonTooShort
+	ReasonInvalidLetter
+	ReasonMissingCenter
+	ReasonNotInDict
+	ReasonDuplicate
+	ReasonDictUnavailable // the dictionary failed, the word was not checked and can be sent again
+	ReasonGameOver        // the game ended, no more words are accepted
+	ReasonTimeUp          // the time of the game ran out, no more words are accepted
+)
+
+func (r Reason) String() string {
+	switch r {
+	case ReasonOK: return "OK"
+	case ReasonTooShort: return "TOO_SHORT"
+	case ReasonInvalidLetter: return "INVALID_LETTER"
+	case ReasonMissingCenter: return "MISSING_CENTER"
+	case ReasonNotInDict: return "NOT_IN_DICT"
+	case ReasonDuplicate: return "DUPLICATE"
+	case ReasonDictUnavailable: return "DICT_UNAVAILABLE"
+	case ReasonGameOver: return "GAME_OVER"
+	case ReasonTimeUp: return "TIME_UP"
+	default: return "ERROR"
+	}
+}
+
+// SubmitResult is what a game answers for a submitted word. Wrappers can fill extra fields for their own variant without changing the Submit signature of every game
+type SubmitResult struct {
+	Valid        bool
+	Reason       Reason
+	Word         string // the word after trimming and lower casing
+	Points       int
+	Total        int
+	MaxScore     int
+	Pangram      bool // uses every letter of the board
+	BoardPangram bool // is the word the board was built from
+	Perfect      bool // pangram that uses every letter exactly once
+	Rank         rank.Standing
+	RankUp       bool // this word crossed a rank threshold
+	Complete     bool // every answer of the board was found
+	Player       string // who submitted the word, empty for games without named players
+	PlayerPoints int    // points of that player after the word
+	FirstBonus   int    // first-finder points, already in Points
+	GameOver     bool   // the game ended, with this word or before it
+	Winner       string
+	Lives        int    // lives left in a hardcore game
+	Penalty      int    // points taken for a miss, already out of Total
+	LostLife     bool   // the word was a miss that cost a life
+}
@@ -0,0 +1,54 @@
+package games
+
+import "time"
+
+// Answer is a word of the board with what it is worth in the game
+type Answer struct {
+	Word    string
+	Points  int
+	Pangram bool
+}
+
+// Answers of a game split into the words found and the words missed
+type Answers struct {
+	Found        []Answer // in the order they were found
+	Missed       []Answer // in the order of the board answers
+	FoundPoints  int
+	MissedPoints int
+}
+
+// Reveal every answer of a game. It is only allowed once the game ended, single player and co-op games end when they are given up (Ender), or when the daily board it was created on rotated: the game started on its board own day, and today, the date of the daily board, is later.
+// A game started on an archived board keeps its answers hidden until it ends, and so does a race that is still played
+func Reveal(game Game, today time.Time) (Answers, error) {
+	state := game.State()
+	board := game.Snapshot().Board()
+	past := !board.Date.IsZero() && sameDay(state.Created, board.Date) && board.Date.Before(today)
+	if secret, ok := game.(Secret); ok && secret.Secret() { past = false }
+	if !state.Ended && !past { return Answers{}, ErrAnswersHidden }
+
+	scorer := state.Options.scorer()
+	answers := Answers{Found: []Answer{}, Missed: []Answer{}}
+	seen := map[string]struct{}{}
+	for _, word := range state.Found {
+		seen[word] = struct{}{}
+		answer := Answer{Word: word, Pangram: board.IsPangram(word)}
+		answer.Points = scorer.Score(len([]rune(word)), answer.Pangram)
+		answers.Found = append(answers.Found, answer)
+		answers.FoundPoints += answer.Points
+	}
+	for _, word := range board.Answers {
+		if _, ok := seen[word]; ok { continue }
+		answer := Answer{Word: word, Pangram: board.IsPangram(word)}
+		answer.Points = scorer.Score(len([]rune(word)), answer.Pangram)
+		answers.Missed = append(answers.Missed, answer)
+		answers.MissedPoints += answer.Points
+	}
+	return answers, nil
+}
+
+// Days are compared in the timezone of the board date, the calendar timezone
+func sameDay(t time.Time, day time.Time) bool {
+	y, m, d := t.In(day.Location()).Date()
+	dy, dm, dd := day.Date()
+	return y == dy && m == dm && d == dd
+}
@@ -0,0 +1,45 @@
+package games_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/luispellizzon/pangram/internal/games"
+	"github.com/luispellizzon/pangram/internal/manager"
+)
+
+// A game started on an archived board has no board rotation to wait for, giving it up is what reveals its answers
+func TestRevealAfterEnd(t *testing.T) {
+	archived := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	store := manager.NewMemoryStore()
+	for _, kind := range []string{"singleplayer", "multiplayer"} {
+		m := newManagerOn(t, archived, store)
+		id, game, tokens, err := m.Create(kind, games.Options{Board: "2024-01-01"}, "Ana", false)
+		if err != nil { t.Fatalf("%s Create: %v", kind, err) }
+		if result := game.Submit("alarm"); !result.Valid { t.Fatalf("%s Submit = %v, want OK", kind, result.Reason) }
+		if _, err := games.Reveal(game, today); !errors.Is(err, games.ErrAnswersHidden) { t.Fatalf("%s Reveal before End = %v, want ErrAnswersHidden", kind, err) }
+
+		if err := m.End(id, tokens.Invite); err == nil { t.Fatalf("%s End with the invite token worked, only the creator can end", kind) }
+		if err := m.End(id, tokens.Player); err != nil { t.Fatalf("%s End: %v", kind, err) }
+		if result := game.Submit("moral"); result.Valid || result.Reason != games.ReasonGameOver || !result.GameOver { t.Fatalf("%s Submit after End = %v, want GAME_OVER", kind, result.Reason) }
+		answers, err := games.Reveal(game, today)
+		if err != nil { t.Fatalf("%s Reveal after End: %v", kind, err) }
+		if len(answers.Found) != 1 || len(answers.Missed) != 2 { t.Fatalf("%s Reveal = %d found and %d missed, want 1 and 2", kind, len(answers.Found), len(answers.Missed)) }
+
+		// the end is saved with the game
+		restarted := newManagerOn(t, archived, store)
+		again, _, err := restarted.Get(id, tokens.Player, manager.Play)
+		if err != nil { t.Fatalf("%s Get after restart: %v", kind, err) }
+		if !again.State().Ended { t.Fatalf("%s game is not ended after restart", kind) }
+	}
+}
+
+// Games with an end of their own can not be given up
+func TestEndNeedsEnder(t *testing.T) {
+	m := newManager(t)
+	id, _, tokens, err := m.Create("race", games.Options{TargetScore: 100}, "Ana", false)
+	if err != nil { t.Fatalf("Create: %v", err) }
+	if err := m.End(id, tokens.Player); !errors.Is(err, games.ErrNoEnd) { t.Fatalf("End of a race = %v, want ErrNoEnd", err) }
+}
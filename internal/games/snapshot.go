@@ -0,0 +1,41 @@
+package games
+
+import (
+	"time"
+
+	"github.com/luispellizzon/pangram/internal/pangram"
+)
+
+// Snapshot is everything needed to rebuild a game after a server restart. Letters are saved as strings so the files stay readable
+type Snapshot struct {
+	Kind     string    `json:"kind"`
+	Letters  string    `json:"letters"`
+	Center   string    `json:"center"`
+	Word     string    `json:"word"`
+	Date     time.Time `json:"date"`
+	Answers  []string  `json:"answers,omitempty"`
+	Pangrams []string  `json:"pangrams,omitempty"`
+	Found    []string  `json:"found"`
+	Total    int       `json:"total"`
+	Created  time.Time `json:"created"`
+	Options  Options   `json:"options"`
+	Players  []Player  `json:"players,omitempty"`
+	FirstBy  map[string]string `json:"first_by,omitempty"` // word to the player that found it first, in a race
+	Ended    bool      `json:"ended,omitempty"`
+	Winner   string    `json:"winner,omitempty"`
+	Misses   int       `json:"misses,omitempty"` // words that cost a life in a hardcore game
+}
+
+func snapshotBoard(board pangram.GameBoard) Snapshot {
+	return Snapshot{
+		Letters: string(board.Letters), Center: string(board.Center), Word: board.Word, Date: board.Date,
+		Answers: board.Answers, Pangrams: board.Pangrams,
+	}
+}
+
+// Board the game was created with
+func (snap Snapshot) Board() pangram.GameBoard {
+	board := pangram.GameBoard{Letters: []rune(snap.Letters), Word: snap.Word, Date: snap.Date, Answers: snap.Answers, Pangrams: snap.Pangrams}
+	if center := []rune(snap.Center); len(center) > 0 { board.Center = center[0] }
+	return board
+}
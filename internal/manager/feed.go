@@ -0,0 +1,123 @@
+package manager
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+var ErrSlowWatcher = errors.New("WATCHER FELL BEHIND THE GAME EVENTS")
+
+// EventKind is what happened in a game. The values follow the EventKind enum of the gRPC API
+type EventKind int32
+
+const (
+	EventUnknown EventKind = iota
+	EventWordFound
+	EventScoreChanged
+	EventRankUp
+	EventPlayerJoined
+	EventPlayerLeft
+	EventGameEnded
+)
+
+// Event pushed to the watchers of a game. Only the fields of its kind are set
+type Event struct {
+	Kind    EventKind
+	Player  string
+	Word    string // empty for watchers that can not see the words of other players yet
+	Points  int
+	Pangram bool
+	Total   int // the game total, the team total in co-op
+	PlayerPoints int
+	Rank    string
+	Winner  string
+	At      time.Time
+	secret  bool // the word is only sent to the player that found it
+}
+
+// watchBuffer is how many events a watcher can be behind before it is dropped
+const watchBuffer = 64
+
+// feed is the in-process pub/sub of one game. Publishing never waits for a watcher: a watcher whose buffer is full is dropped and told to watch again, so a slow client can not hold the game lock
+type feed struct {
+	mu       sync.Mutex
+	watchers map[*Watcher]struct{}
+	closed   error
+}
+
+// Watcher receives the events of one game until it is closed. When Events is closed, Err tells why
+type Watcher struct {
+	Events <-chan Event
+	events chan Event
+	player string
+	feed   *feed
+	err    error
+}
+
+func newFeed() *feed { return &feed{watchers: map[*Watcher]struct{}{}} }
+
+func (f *feed) watch(player string) (*Watcher, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.closed != nil { return nil, f.closed }
+	events := make(chan Event, watchBuffer)
+	w := &Watcher{Events: events, events: events, player: player, feed: f}
+	f.watchers[w] = struct{}{}
+	return w, nil
+}
+
+func (f *feed) publish(events ...Event) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, event := range events {
+		for w := range f.watchers {
+			sent := event
+			if sent.secret && sent.Player != w.player { sent.Word = "" }
+			select {
+			case w.events <- sent:
+			default:
+				f.drop(w, ErrSlowWatcher)
+			}
+		}
+	}
+}
+
+// Close every watcher, used when the game is deleted
+func (f *feed) close(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.closed = err
+	for w := range f.watchers { f.drop(w, err) }
+}
+
+// Close the watchers of a player that left. The stream ends cleanly, and watching again is refused because the token no longer works
+func (f *feed) leave(player string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for w := range f.watchers {
+		if w.player == player { f.drop(w, nil) }
+	}
+}
+
+// the caller holds the lock
+func (f *feed) drop(w *Watcher, err error) {
+	if _, ok := f.watchers[w]; !ok { return }
+	delete(f.watchers, w)
+	w.err = err
+	close(w.events)
+}
+
+// Stop watching, the Events channel is closed
+func (w *Watcher) Close() {
+	w.feed.mu.Lock()
+	defer w.feed.mu.Unlock()
+	w.feed.drop(w, nil)
+}
+
+// Why the Events channel was closed, nil when the watcher closed it
+func (w *Watcher) Err() error {
+	w.feed.mu.Lock()
+	defer w.feed.mu.Unlock()
+	return w.err
+}
@@ -1,47 +1,438 @@
 package manager
 
 import (
+	"errors"
 	"fmt"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/luispellizzon/pangram/internal/games"
+	"github.com/luispellizzon/pangram/internal/logger"
 )
 
-// GameManager interface
+// GameManager interface. Get checks the token sent by the client and returns the access it grants.
+// Games with named players get the creator as their first player, and Join adds a player with a token of its own
 type Manager interface {
-	Create(kind string) (string, games.Game, error)
-	Get(id string) (games.Game, bool)
+	Create(kind string, options games.Options, player string, share bool) (string, games.Game, Tokens, error)
+	Get(id string, token string, need Access) (games.Game, Access, error)
+	Join(id string, token string, player string) (string, games.Game, error)
+	Leave(id string, token string) error
+	Watch(id string, token string) (*Watcher, error)
+	End(id string, token string) error
+	Delete(id string) error
+	Close()
+}
+
+var (
+	ErrNotFound     = errors.New("GAME NOT FOUND")
+	ErrTooManyGames = errors.New("TOO MANY LIVE GAMES")
+	ErrOwnerLeave   = errors.New("THE PLAYER THAT CREATED THE GAME CAN NOT LEAVE IT, DELETE IT INSTEAD")
+)
+
+// Config limits how many games the manager keeps in memory and for how long. Zero values mean no limit
+type Config struct {
+	TTL        time.Duration // games idle for longer are removed
+	MaxGames   int           // Create fails with ErrTooManyGames when reached
+	SweepEvery time.Duration // how often idle games are looked for, defaults to half the TTL up to a minute
 }
 
 // mgr is the server manager that will save all games and will act as a singleton and also a proxy, since all requests will call this manager to grab a game by id from its inGames mapper or to create new games
 type mgr struct {
 	mu     sync.RWMutex
-	nextID int
-	inGames  map[string]games.Game
+	inGames  map[string]*storedGame
 	factory *games.Factory
+	store   Store
+	config  Config
+	now     func() time.Time
+	done    chan struct{}
+	closeOnce sync.Once
+}
+
+// Create the manager, restore every game saved in the store and start the idle games sweeper
+func New(factory *games.Factory, store Store, config Config) (Manager, error) {
+	m := &mgr{inGames: map[string]*storedGame{}, factory: factory, store: store, config: config, now: time.Now, done: make(chan struct{})}
+	records, err := store.Load()
+	if err != nil { return nil, err }
+	for id, record := range records {
+		game, err := factory.Restore(record.Game)
+		if err != nil { logger.Log().Errorf("RESTORE GAME %s: %v", id, err); continue }
+		// restored games start their idle time again from the restart
+		// games saved before invite tokens used the share token as the invite, it keeps working for them
+		invite := record.InviteToken
+		if _, named := game.(games.WithPlayers); named && invite == "" { invite = record.ShareToken }
+		m.inGames[id] = m.track(id, game, record.PlayerToken, record.ShareToken, invite)
+		for hash, player := range record.Players { m.inGames[id].seats[hash] = player }
+	}
+	if len(m.inGames) > 0 { logger.Log().Infof("RESTORED %d GAMES", len(m.inGames)) }
+	if config.TTL > 0 { go m.sweep() }
+	return m, nil
 }
 
-func New(factory *games.Factory) Manager {
-	return &mgr{inGames: map[string]games.Game{}, factory: factory}
+func (m *mgr) track(id string, game games.Game, playerHash string, shareHash string, inviteHash string) *storedGame {
+	stored := &storedGame{Game: game, id: id, store: m.store, now: m.now, playerHash: playerHash, shareHash: shareHash, inviteHash: inviteHash, seats: map[string]string{}, feed: newFeed()}
+	stored.touch()
+	stored.schedule()
+	return stored
+}
+
+func (m *mgr) find(id string) (*storedGame, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	g, ok := m.inGames[id]
+	if !ok { return nil, fmt.Errorf("%w: %s", ErrNotFound, id) }
+	return g, nil
 }
 
-// Create new game using the server factory
-func (m *mgr) Create(kind string) (string, games.Game, error) {
-	game, err := m.factory.New(kind)
+// Create new game using the server factory. The player token is always created, the share token only when spectators are allowed.
+// Games with named players also get an invite token for friends to join. The share token stays read-only, so a spectator link can not take a seat
+func (m *mgr) Create(kind string, options games.Options, player string, share bool) (string, games.Game, Tokens, error) {
+	game, err := m.factory.New(kind, options)
 	if err != nil { 
-		return "", nil, err
+		return "", nil, Tokens{}, err
+	}
+	players, named := game.(games.WithPlayers)
+	if named {
+		// the seat keeps the name the game knows the player by, so a name sent with spaces still plays
+		if player, err = players.Join(player); err != nil { return "", nil, Tokens{}, err }
+	}
+	tokens := Tokens{Player: newToken()}
+	shareHash, inviteHash := "", ""
+	if share {
+		tokens.Share = newToken()
+		shareHash = hashToken(tokens.Share)
+	}
+	if named {
+		tokens.Invite = newToken()
+		inviteHash = hashToken(tokens.Invite)
 	}
 	m.mu.Lock()
 	defer m.mu.Unlock()
-	m.nextID++
-	id := fmt.Sprintf("g-%d", m.nextID)
-	m.inGames[id] = game
-	return id, game, nil
+	if m.config.MaxGames > 0 && len(m.inGames) >= m.config.MaxGames {
+		return "", nil, Tokens{}, fmt.Errorf("%w: limit is %d", ErrTooManyGames, m.config.MaxGames)
+	}
+	id := newID()
+	for _, taken := m.inGames[id]; taken; _, taken = m.inGames[id] { id = newID() }
+	stored := m.track(id, game, hashToken(tokens.Player), shareHash, inviteHash)
+	if named { stored.seats[stored.playerHash] = player }
+	stored.save()
+	m.inGames[id] = stored
+	return id, stored.seat(stored.playerHash), tokens, nil
 }
 
-// Get game by id, the game is saved inside the manager singleton inGames map
-func (m *mgr) Get(id string) (games.Game, bool) {
-	m.mu.RLock(); defer m.mu.RUnlock()
+// Get game by id, the game is saved inside the manager singleton inGames map. The player token gives Play access, the share token only Watch. Reading a game counts as activity
+func (m *mgr) Get(id string, token string, need Access) (games.Game, Access, error) {
+	g, err := m.find(id)
+	if err != nil { return nil, Watch, err }
+	if token == "" { return nil, Watch, ErrNoToken }
+	access, hash := g.access(token)
+	if hash == "" { return nil, Watch, ErrBadToken }
+	if access < need {
+		if access == Watch { return nil, access, ErrReadOnly }
+		return nil, access, ErrNotOwner
+	}
+	g.touch()
+	return g.seat(hash), access, nil
+}
+
+// Join a game with named players. The invite token or the token of the creator lets a player in, the new player gets a token of its own
+func (m *mgr) Join(id string, token string, player string) (string, games.Game, error) {
+	g, err := m.find(id)
+	if err != nil { return "", nil, err }
+	if token == "" { return "", nil, ErrNoToken }
+	_, invite := g.access(token)
+	if invite == "" { return "", nil, ErrBadToken }
+	players, named := g.Game.(games.WithPlayers)
+	if !named { return "", nil, fmt.Errorf("%w: %s", games.ErrNoPlayers, g.Name()) }
+	if invite != g.inviteHash && invite != g.playerHash { return "", nil, ErrNotInvite }
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.removed { return "", nil, fmt.Errorf("%w: %s", ErrNotFound, id) }
+	player, err = players.Join(player)
+	if err != nil { return "", nil, err }
+	seatToken := newToken()
+	hash := hashToken(seatToken)
+	g.seatsMu.Lock()
+	g.seats[hash] = player
+	g.seatsMu.Unlock()
+	g.touch()
+	g.save()
+	joined := g.seat(hash)
+	g.feed.publish(Event{Kind: EventPlayerJoined, Player: PlayerOf(joined), At: m.now()})
+	return seatToken, joined, nil
+}
+
+// Leave a game with named players. The token of the player stops working, the words and points stay in the game
+func (m *mgr) Leave(id string, token string) error {
+	g, err := m.find(id)
+	if err != nil { return err }
+	if token == "" { return ErrNoToken }
+	access, hash := g.access(token)
+	if hash == "" { return ErrBadToken }
+	if access == Watch { return ErrReadOnly }
+	if hash == g.playerHash { return ErrOwnerLeave }
+	player := PlayerOf(g.seat(hash))
+	if player == "" { return fmt.Errorf("%w: %s", games.ErrNoPlayers, g.Name()) }
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.removed { return fmt.Errorf("%w: %s", ErrNotFound, id) }
+	if err := g.Game.(games.WithPlayers).Leave(player); err != nil { return err }
+	g.seatsMu.Lock()
+	delete(g.seats, hash)
+	g.seatsMu.Unlock()
+	g.touch()
+	g.save()
+	g.feed.publish(Event{Kind: EventPlayerLeft, Player: player, At: m.now()})
+	g.feed.leave(player)
+	return nil
+}
+
+// Watch the events of a game. Any token of the game can watch, players also see their own words in secret games
+func (m *mgr) Watch(id string, token string) (*Watcher, error) {
+	game, _, err := m.Get(id, token, Watch)
+	if err != nil { return nil, err }
+	g, err := m.find(id)
+	if err != nil { return nil, err }
+	return g.feed.watch(PlayerOf(game))
+}
+
+// End a game that has no end of its own, only the player that created it can. The game stays so its answers can be revealed
+func (m *mgr) End(id string, token string) error {
+	game, _, err := m.Get(id, token, Own)
+	if err != nil { return err }
+	g, err := m.find(id)
+	if err != nil { return err }
+	ender, ok := g.Game.(games.Ender)
+	if !ok { return fmt.Errorf("%w: %s", games.ErrNoEnd, game.Name()) }
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.removed { return fmt.Errorf("%w: %s", ErrNotFound, id) }
+	if g.ended { return nil }
+	ender.End()
+	g.ended = true
+	g.save()
+	g.feed.publish(Event{Kind: EventGameEnded, At: m.now()})
+	return nil
+}
+
+// Delete a game from memory and from the store
+func (m *mgr) Delete(id string) error {
+	m.mu.Lock()
+	g, ok := m.inGames[id]
+	delete(m.inGames, id)
+	m.mu.Unlock()
+	if !ok { return fmt.Errorf("%w: %s", ErrNotFound, id) }
+	return m.remove(id, g)
+}
+
+// Free a game already taken out of inGames. Handles given out before keep pointing at it, so it is marked removed and none of them can write it back to the store
+func (m *mgr) remove(id string, g *storedGame) error {
+	g.stop()
+	g.feed.close(fmt.Errorf("%w: %s", ErrNotFound, id))
+	return m.store.Delete(id)
+}
+
+// Delete a game only if it is still idle. The idle time is checked again under the lock, so a game used since the sweep listed it is kept
+func (m *mgr) expire(id string) (bool, error) {
+	m.mu.Lock()
 	g, ok := m.inGames[id]
-	return g, ok
+	if !ok || !g.lastActive().Before(m.now().Add(-m.config.TTL)) {
+		m.mu.Unlock()
+		return false, nil
+	}
+	delete(m.inGames, id)
+	m.mu.Unlock()
+	return true, m.remove(id, g)
+}
+
+// Stop the sweeper
+func (m *mgr) Close() { m.closeOnce.Do(func() { close(m.done) }) }
+
+// Remove games idle for longer than the TTL, so abandoned games do not grow the server memory forever
+func (m *mgr) sweep() {
+	every := m.config.SweepEvery
+	if every <= 0 { every = min(m.config.TTL/2, time.Minute) }
+	ticker := time.NewTicker(every)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.done:
+			return
+		case <-ticker.C:
+			for _, id := range m.idle() {
+				expired, err := m.expire(id)
+				if err != nil { logger.Log().Errorf("EXPIRE GAME %s: %v", id, err); continue }
+				if expired { logger.Log().Infof("GAME EXPIRED - ID: %s", id) }
+			}
+		}
+	}
+}
+
+func (m *mgr) idle() []string {
+	m.mu.RLock(); defer m.mu.RUnlock()
+	deadline := m.now().Add(-m.config.TTL)
+	ids := []string{}
+	for id, game := range m.inGames {
+		if game.lastActive().Before(deadline) { ids = append(ids, id) }
+	}
+	return ids
+}
+
+// storedGame wraps a game and saves its snapshot to the store after every accepted word. It also keeps the last time the game was used
+type storedGame struct {
+	games.Game
+	mu    sync.Mutex
+	id    string
+	store Store
+	now   func() time.Time
+	active atomic.Int64 // unix nanoseconds
+	playerHash string
+	shareHash  string
+	inviteHash string
+	seatsMu    sync.RWMutex
+	seats      map[string]string // token hash to player name, for games with named players
+	feed       *feed
+	ended      bool // the game ended event was published
+	removed    bool // deleted or expired, set under mu
+	timer      *time.Timer
+}
+
+// Forward the Secret of the wrapped game, so callers of the manager can tell a race that is still played
+func (game *storedGame) Secret() bool {
+	secret, ok := game.Game.(games.Secret)
+	return ok && secret.Secret()
+}
+
+func (game *storedGame) touch() { game.active.Store(game.now().UnixNano()) }
+func (game *storedGame) lastActive() time.Time { return time.Unix(0, game.active.Load()) }
+
+// What a token gives on this game and the hash it matched, an empty hash when the token is not of this game
+func (game *storedGame) access(token string) (Access, string) {
+	if matches(token, game.playerHash) { return Own, game.playerHash }
+	game.seatsMu.RLock()
+	defer game.seatsMu.RUnlock()
+	for hash := range game.seats {
+		if matches(token, hash) { return Play, hash }
+	}
+	if matches(token, game.shareHash) { return Watch, game.shareHash }
+	if matches(token, game.inviteHash) { return Watch, game.inviteHash }
+	return Watch, ""
+}
+
+// The game as seen by the owner of a token. Named players submit their words in their own name
+func (game *storedGame) seat(hash string) games.Game {
+	game.seatsMu.RLock()
+	defer game.seatsMu.RUnlock()
+	if player, ok := game.seats[hash]; ok { return &seat{storedGame: game, player: player} }
+	return game
+}
+
+// Write the record of the game to the store, the caller holds the lock. A removed game is not written, it would come back on the next restart
+func (game *storedGame) save() {
+	if game.removed { return }
+	game.seatsMu.RLock()
+	seats := make(map[string]string, len(game.seats))
+	for hash, player := range game.seats { seats[hash] = player }
+	game.seatsMu.RUnlock()
+	record := Record{Game: game.Game.Snapshot(), PlayerToken: game.playerHash, ShareToken: game.shareHash, InviteToken: game.inviteHash, Players: seats}
+	if err := game.store.Save(game.id, record); err != nil { logger.Log().Errorf("SAVE GAME %s: %v", game.id, err) }
+}
+
+// The lock keeps snapshots of the same game saved in the order words were accepted
+func (game *storedGame) Submit(word string) games.SubmitResult {
+	return game.submit(func() games.SubmitResult { return game.Game.Submit(word) })
+}
+
+func (game *storedGame) submit(play func() games.SubmitResult) games.SubmitResult {
+	game.touch()
+	game.mu.Lock()
+	defer game.mu.Unlock()
+	// a handle taken before the game was deleted, the word is not played
+	if game.removed { return games.SubmitResult{Reason: games.ReasonError} }
+	result := play()
+	if result.Valid || result.LostLife { game.save() }
+	game.publish(result)
+	return result
+}
+
+// Events of a submitted word, the caller holds the lock
+func (game *storedGame) publish(result games.SubmitResult) {
+	at := game.now()
+	events := []Event{}
+	if result.Valid {
+		events = append(events,
+			Event{Kind: EventWordFound, Player: result.Player, Word: result.Word, Points: result.Points, Pangram: result.Pangram, At: at, secret: game.Secret()},
+			Event{Kind: EventScoreChanged, Player: result.Player, Total: result.Total, PlayerPoints: result.PlayerPoints, Rank: result.Rank.Name, At: at},
+		)
+		if result.RankUp { events = append(events, Event{Kind: EventRankUp, Player: result.Player, Rank: result.Rank.Name, At: at}) }
+	}
+	if result.Penalty > 0 { events = append(events, Event{Kind: EventScoreChanged, Player: result.Player, Total: result.Total, Rank: result.Rank.Name, At: at}) }
+	if result.GameOver && !game.ended {
+		game.ended = true
+		events = append(events, Event{Kind: EventGameEnded, Winner: result.Winner, At: at})
+	}
+	game.feed.publish(events...)
+}
+
+// Games with a time limit end without a word being sent, a timer publishes the end when the time runs out
+func (game *storedGame) schedule() {
+	state := game.Game.State()
+	if state.Ended { game.ended = true; return }
+	if state.EndsAt.IsZero() { return }
+	game.timer = time.AfterFunc(state.EndsAt.Sub(game.now()), game.expire)
+}
+
+func (game *storedGame) expire() {
+	state := game.Game.State()
+	game.mu.Lock()
+	defer game.mu.Unlock()
+	if game.ended || game.removed { return }
+	if !state.Ended {
+		// the timer fired before the clock of the game says it ended, look again when it should have
+		wait := max(state.EndsAt.Sub(game.now()), time.Second)
+		if !state.EndsAt.IsZero() { game.timer = time.AfterFunc(wait, game.expire) }
+		return
+	}
+	game.ended = true
+	game.save()
+	game.feed.publish(Event{Kind: EventGameEnded, Winner: state.Winner, At: game.now()})
+}
+
+// Mark the game removed and stop its end timer
+func (game *storedGame) stop() {
+	game.mu.Lock()
+	defer game.mu.Unlock()
+	game.removed = true
+	if game.timer != nil { game.timer.Stop() }
+}
+
+// Removed tells if a game handed out by Create, Get or Join was deleted or expired since, its words are not played anymore
+func Removed(game games.Game) bool {
+	var stored *storedGame
+	switch g := game.(type) {
+	case *seat: stored = g.storedGame
+	case *storedGame: stored = g
+	default: return false
+	}
+	stored.mu.Lock()
+	defer stored.mu.Unlock()
+	return stored.removed
+}
+
+// seat is a game with named players handed to one of them
+type seat struct {
+	*storedGame
+	player string
+}
+
+func (s *seat) Submit(word string) games.SubmitResult {
+	return s.submit(func() games.SubmitResult { return s.Game.(games.WithPlayers).SubmitAs(s.player, word) })
+}
+
+func (s *seat) State() games.State { return s.Game.(games.WithPlayers).StateAs(s.player) }
+
+// PlayerOf returns the name of the player a game was handed to by Create, Get or Join, empty for games without named players or spectators
+func PlayerOf(game games.Game) string {
+	if s, ok := game.(*seat); ok { return s.player }
+	return ""
 }
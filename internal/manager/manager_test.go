@@ -0,0 +1,102 @@
+package manager_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/luispellizzon/pangram/internal/games"
+	"github.com/luispellizzon/pangram/internal/manager"
+	"github.com/luispellizzon/pangram/internal/pangram"
+	"github.com/luispellizzon/pangram/internal/rank"
+)
+
+// Stub dictionary, only the words of the test board exist
+type words map[string]struct{}
+
+func (w words) Has(word string) (bool, error) { _, ok := w[word]; return ok, nil }
+
+// Stub provider, every board option gives the same board
+type boards struct{ board pangram.GameBoard }
+
+func (b boards) Board() (pangram.GameBoard, error) { return b.board, nil }
+func (b boards) BoardFor(string) (pangram.GameBoard, error) { return b.board, nil }
+func (b boards) Puzzle(int) (pangram.GameBoard, error) { return b.board, nil }
+func (b boards) Random() (pangram.GameBoard, error) { return b.board, nil }
+
+func newFactory() *games.Factory {
+	board := pangram.GameBoard{
+		Letters: []rune("formyla"), Center: 'm', Word: "formally", Date: time.Now(),
+		Answers: []string{"alarm", "formally", "moral"}, Pangrams: []string{"formally"},
+	}
+	return &games.Factory{Dict: words{"alarm": {}, "formally": {}, "moral": {}}, Board: boards{board}, Ranks: rank.Default()}
+}
+
+func newManager(t *testing.T, store manager.Store) manager.Manager {
+	t.Helper()
+	m, err := manager.New(newFactory(), store, manager.Config{})
+	if err != nil { t.Fatalf("manager.New: %v", err) }
+	t.Cleanup(m.Close)
+	return m
+}
+
+// A handle taken before Delete must not play words or write the game back to the store
+func TestDeleteThenSubmit(t *testing.T) {
+	store := manager.NewMemoryStore()
+	m := newManager(t, store)
+	id, _, tokens, err := m.Create("multiplayer", games.Options{}, "Ana", false)
+	if err != nil { t.Fatalf("Create: %v", err) }
+	friend, _, err := m.Join(id, tokens.Invite, "Bea")
+	if err != nil { t.Fatalf("Join: %v", err) }
+	game, _, err := m.Get(id, tokens.Player, manager.Play)
+	if err != nil { t.Fatalf("Get: %v", err) }
+
+	if err := m.Delete(id); err != nil { t.Fatalf("Delete: %v", err) }
+	if result := game.Submit("alarm"); result.Valid || result.Reason != games.ReasonError { t.Fatalf("Submit after Delete = %v, want ERROR", result.Reason) }
+	if !manager.Removed(game) { t.Fatalf("Removed = false after Delete") }
+	if _, _, err := m.Join(id, tokens.Invite, "Cy"); !errors.Is(err, manager.ErrNotFound) { t.Fatalf("Join after Delete = %v, want ErrNotFound", err) }
+	if err := m.Leave(id, friend); !errors.Is(err, manager.ErrNotFound) { t.Fatalf("Leave after Delete = %v, want ErrNotFound", err) }
+
+	records, err := store.Load()
+	if err != nil { t.Fatalf("Load: %v", err) }
+	if _, ok := records[id]; ok { t.Fatalf("deleted game is back in the store") }
+	restarted := newManager(t, store)
+	if _, _, err := restarted.Get(id, tokens.Player, manager.Play); !errors.Is(err, manager.ErrNotFound) { t.Fatalf("Get after restart = %v, want ErrNotFound", err) }
+}
+
+// The handle of a game that is still live keeps playing
+func TestSubmitSaves(t *testing.T) {
+	store := manager.NewMemoryStore()
+	m := newManager(t, store)
+	id, game, _, err := m.Create("singleplayer", games.Options{}, "", false)
+	if err != nil { t.Fatalf("Create: %v", err) }
+	if result := game.Submit("alarm"); !result.Valid { t.Fatalf("Submit = %v, want OK", result.Reason) }
+	if manager.Removed(game) { t.Fatalf("Removed = true for a live game") }
+	records, err := store.Load()
+	if err != nil { t.Fatalf("Load: %v", err) }
+	if found := records[id].Game.Found; len(found) != 1 || found[0] != "alarm" { t.Fatalf("saved words = %v, want [alarm]", found) }
+}
+
+// Players are seated under the name the game knows them by, so names sent with spaces or in another case still play
+func TestSeatNames(t *testing.T) {
+	for _, kind := range []string{"multiplayer", "race"} {
+		m := newManager(t, manager.NewMemoryStore())
+		options := games.Options{}
+		if kind == "race" { options.TargetScore = 100 }
+		id, created, tokens, err := m.Create(kind, options, " Ana ", false)
+		if err != nil { t.Fatalf("%s Create: %v", kind, err) }
+		if player := manager.PlayerOf(created); player != "Ana" { t.Fatalf("%s creator seated as %q, want Ana", kind, player) }
+		if result := created.Submit("alarm"); !result.Valid { t.Fatalf("%s Submit from the Create handle = %v, want OK", kind, result.Reason) }
+		game, _, err := m.Get(id, tokens.Player, manager.Play)
+		if err != nil { t.Fatalf("%s Get: %v", kind, err) }
+		if result := game.Submit("moral"); !result.Valid { t.Fatalf("%s Submit from Get = %v, want OK", kind, result.Reason) }
+
+		friend, _, err := m.Join(id, tokens.Invite, "Bea")
+		if err != nil { t.Fatalf("%s Join: %v", kind, err) }
+		if err := m.Leave(id, friend); err != nil { t.Fatalf("%s Leave: %v", kind, err) }
+		_, back, err := m.Join(id, tokens.Invite, " bea ")
+		if err != nil { t.Fatalf("%s Join again: %v", kind, err) }
+		if player := manager.PlayerOf(back); player != "Bea" { t.Fatalf("%s player came back as %q, want Bea", kind, player) }
+		if result := back.Submit("formally"); !result.Valid { t.Fatalf("%s Submit after coming back = %v, want OK", kind, result.Reason) }
+	}
+}
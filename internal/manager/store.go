@@ -0,0 +1,128 @@
+package manager
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/luispellizzon/pangram/internal/games"
+	"github.com/luispellizzon/pangram/internal/logger"
+)
+
+// Store keeps game records outside the manager, so games can be restored when the server starts
+type Store interface {
+	Save(id string, record Record) error
+	Delete(id string) error
+	Load() (map[string]Record, error)
+}
+
+// Record is a game snapshot with the hashes of its access tokens, one for each named player in games that have them
+type Record struct {
+	Game        games.Snapshot `json:"game"`
+	PlayerToken string         `json:"player_token_sha256"`
+	ShareToken  string         `json:"share_token_sha256,omitempty"`
+	InviteToken string         `json:"invite_token_sha256,omitempty"`
+	Players     map[string]string `json:"player_tokens_sha256,omitempty"` // token hash to player name
+}
+
+// MemoryStore keeps records in memory, games are lost on restart. Used when no data directory is configured
+type MemoryStore struct {
+	mu    sync.Mutex
+	records map[string]Record
+}
+
+func NewMemoryStore() *MemoryStore { return &MemoryStore{records: map[string]Record{}} }
+
+func (s *MemoryStore) Save(id string, record Record) error {
+	s.mu.Lock(); defer s.mu.Unlock()
+	s.records[id] = record
+	return nil
+}
+
+func (s *MemoryStore) Delete(id string) error {
+	s.mu.Lock(); defer s.mu.Unlock()
+	delete(s.records, id)
+	return nil
+}
+
+func (s *MemoryStore) Load() (map[string]Record, error) {
+	s.mu.Lock(); defer s.mu.Unlock()
+	records := make(map[string]Record, len(s.records))
+	for id, record := range s.records { records[id] = record }
+	return records, nil
+}
+
+// FileStore saves one JSON record per game in a data directory. Files are written to a temp file first and renamed, so a crash never leaves half a snapshot behind
+type FileStore struct {
+	mu  sync.Mutex
+	dir string
+}
+
+func NewFileStore(dir string) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil { return nil, err }
+	return &FileStore{dir: dir}, nil
+}
+
+func (s *FileStore) path(id string) string { return filepath.Join(s.dir, filepath.Base(id)+".json") }
+
+func (s *FileStore) Save(id string, record Record) error {
+	bytes, err := json.MarshalIndent(record, "", "  ")
+	if err != nil { return err }
+	s.mu.Lock(); defer s.mu.Unlock()
+	return s.write(id, bytes)
+}
+
+// the caller holds the lock
+func (s *FileStore) write(id string, bytes []byte) error {
+	tmp, err := os.CreateTemp(s.dir, id+".*.tmp")
+	if err != nil { return err }
+	if _, err := tmp.Write(bytes); err != nil { tmp.Close(); os.Remove(tmp.Name()); return err }
+	if err := tmp.Close(); err != nil { os.Remove(tmp.Name()); return err }
+	return os.Rename(tmp.Name(), s.path(id))
+}
+
+func (s *FileStore) Delete(id string) error {
+	s.mu.Lock(); defer s.mu.Unlock()
+	if err := os.Remove(s.path(id)); err != nil && !errors.Is(err, os.ErrNotExist) { return err }
+	return nil
+}
+
+// Load every record of the data directory. A broken file is logged and skipped so one bad game does not stop the server
+func (s *FileStore) Load() (map[string]Record, error) {
+	s.mu.Lock(); defer s.mu.Unlock()
+	entries, err := os.ReadDir(s.dir)
+	if err != nil { return nil, err }
+	records := map[string]Record{}
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".json" { continue }
+		bytes, err := os.ReadFile(filepath.Join(s.dir, entry.Name()))
+		if err != nil { logger.Log().Errorf("STORE %s: %v", entry.Name(), err); continue }
+		id := strings.TrimSuffix(entry.Name(), ".json")
+		var record Record
+		if err := json.Unmarshal(bytes, &record); err != nil { logger.Log().Errorf("STORE %s: %v", entry.Name(), err); continue }
+		if record.Game.Kind == "" {
+			migrated, err := s.migrate(id, bytes)
+			if err != nil { logger.Log().Errorf("STORE %s: %v", entry.Name(), err); continue }
+			record = migrated
+		}
+		records[id] = record
+	}
+	return records, nil
+}
+
+// Files saved before games had tokens hold a bare snapshot. The game is wrapped in a record with a new player token, which is logged once so the player can be given it, and the file is rewritten in the new shape. The caller holds the lock
+func (s *FileStore) migrate(id string, bytes []byte) (Record, error) {
+	var snap games.Snapshot
+	if err := json.Unmarshal(bytes, &snap); err != nil { return Record{}, err }
+	if snap.Kind == "" { return Record{}, errors.New("NOT A GAME RECORD OR SNAPSHOT") }
+	token := newToken()
+	record := Record{Game: snap, PlayerToken: hashToken(token)}
+	migrated, err := json.MarshalIndent(record, "", "  ")
+	if err != nil { return Record{}, err }
+	if err := s.write(id, migrated); err != nil { return Record{}, err }
+	logger.Log().Infof("MIGRATED GAME %s - PLAYER TOKEN: %s", id, token)
+	return record, nil
+}
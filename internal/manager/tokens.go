@@ -0,0 +1,60 @@
+package manager
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/hex"
+	"errors"
+	"strings"
+)
+
+var (
+	ErrNoToken   = errors.New("GAME TOKEN REQUIRED")
+	ErrBadToken  = errors.New("GAME TOKEN DOES NOT MATCH")
+	ErrReadOnly  = errors.New("SHARE TOKEN IS READ-ONLY")
+	ErrNotOwner  = errors.New("ONLY THE PLAYER THAT CREATED THE GAME CAN DO THIS")
+	ErrNotInvite = errors.New("TOKEN CAN NOT INVITE PLAYERS")
+)
+
+// Access is what a token allows on a game
+type Access int
+
+const (
+	Watch Access = iota // read the game state, given by the share token and the invite token
+	Play                // submit words, given by the token of every named player
+	Own                 // also delete the game, given by the player token of the creator
+)
+
+// Tokens handed out when a game is created. Only their hashes are kept, so a leaked data directory does not leak access to the games
+type Tokens struct {
+	Player string
+	Share  string // empty when the game was created without spectators
+	Invite string // lets friends join, empty for games without named players
+}
+
+var idEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// random bytes from the OS, ids and tokens must never be guessable
+func random(n int) string {
+	bytes := make([]byte, n)
+	if _, err := rand.Read(bytes); err != nil { panic(err) }
+	return strings.ToLower(idEncoding.EncodeToString(bytes))
+}
+
+// Game ids are random, so knowing one game id tells nothing about the others
+func newID() string { return "g-" + random(10) }
+
+func newToken() string { return random(20) }
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// Compare a token with a saved hash in constant time
+func matches(token string, hash string) bool {
+	if token == "" || hash == "" { return false }
+	return subtle.ConstantTimeCompare([]byte(hashToken(token)), []byte(hash)) == 1
+}
@@ -0,0 +1,32 @@
+package pangram
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// The archive keeps every board built since the server started, one per day, so going back to a past puzzle does not solve the board again.
+// Boards are reproduced from the calendar day, so the archive is only a store in front of the Source: every replica and restart builds the same board for the same day
+
+// archived returns the board of a day from the archive, building it on the first call. The caller holds the lock
+func archived(day time.Time) (GameBoard, error) {
+	key := day.Format(time.DateOnly)
+	if board, ok := archive[key]; ok { return board, nil }
+	board, err := src.PangramFor(day)
+	if err != nil { return GameBoard{}, err }
+	archive[key] = board
+	return board, nil
+}
+
+// BoardNumber returns the board of a puzzle number, puzzle number one is the epoch day. Puzzles after today's are refused like future dates
+func BoardNumber(number int) (GameBoard, error) {
+	mu.Lock()
+	defer mu.Unlock()
+	if src == nil { return GameBoard{}, errors.New("GAME-BOARD SOURCE NOT INITIALIZED") }
+	if number < 1 { return GameBoard{}, fmt.Errorf("%w: puzzle numbers start at 1", ErrBadDate) }
+	if today := cal.Number(cal.Today()); number > today {
+		return GameBoard{}, fmt.Errorf("%w: puzzle %d is not out yet, today is puzzle %d", ErrBadDate, number, today)
+	}
+	return archived(cal.DayOf(number))
+}
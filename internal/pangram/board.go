@@ -3,25 +3,68 @@ package pangram
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"math/rand"
 	"os"
+	"sort"
 	"strings"
 	"sync"
 	"time"
+
+	"github.com/luispellizzon/pangram/internal/score"
 )
 
 // GameBoard singleton that will serve as a backbone for creation of new games.
 // I wanted to make my game the same as Wordle, where everyone around the word gets the same information so friend can play as a singleplayer but challenging each other.
-// So here, every have will have the same pangram (which is chosen randomly), letters and center letter. 
+// So here, every have will have the same pangram (which is chosen from the calendar date), letters and center letter. 
 type GameBoard struct {
 	Letters []rune
 	Center rune
 	Word string
+	Date time.Time
+	Number int // puzzle number of the day, 0 for random boards
+	Answers []string // every dictionary word playable on this board, empty when no dictionary index was given
+	Pangrams []string // answers that use all the letters
+}
+
+// IsPangram checks if a word uses every letter of the board
+func (b GameBoard) IsPangram(word string) bool {
+	used := map[rune]struct{}{}
+	for _, r := range word { used[r] = struct{}{} }
+	for _, r := range b.Letters {
+		if _, ok := used[r]; !ok { return false }
+	}
+	return true
+}
+
+// WithMinLength drops the answers shorter than a game minimum word length
+func (b GameBoard) WithMinLength(n int) GameBoard {
+	filter := func(words []string) []string {
+		kept := []string{}
+		for _, word := range words {
+			if len([]rune(word)) >= n { kept = append(kept, word) }
+		}
+		return kept
+	}
+	b.Answers = filter(b.Answers)
+	b.Pangrams = filter(b.Pangrams)
+	return b
+}
+
+// MaxScore is the score of a game that found every answer, under the given scoring strategy
+func (b GameBoard) MaxScore(scorer score.Scorer) int {
+	total := 0
+	for _, word := range b.Answers { total += scorer.Score(len(word), b.IsPangram(word)) }
+	return total
 }
 
-// Source interface is where I want a loader to create the GameBoard  singleton
-type Source interface { TodaysPangram() (GameBoard, error) }
+// Source interface is where I want a loader to create the GameBoard for a given day, or a random board outside of the daily rotation
+type Source interface {
+	PangramFor(date time.Time) (GameBoard, error)
+	RandomPangram() (GameBoard, error)
+}
 
+// Load pangrams sorted, so every replica picks from the same ordered list no matter how the JSON map was iterated
 func LoadPangramsJSON(path string) ([]string, error) {
 	bytes, err := os.ReadFile(path)
 	if err != nil { return nil, err }
@@ -34,6 +77,7 @@ func LoadPangramsJSON(path string) ([]string, error) {
 		key = strings.TrimSpace(key)
 		if key != "" { words = append(words, key) }
 	}
+	sort.Strings(words)
 	return words, nil
 }
 
@@ -50,41 +94,99 @@ func LettersFromWord(p string) ([]rune, error) {
 	return letters, nil
 }
 
-// Todays Board creator
-type CurrentTodaysPangram struct { Words []string }
+// Todays Board creator. Picks from validated candidates only, so every board has the right number of letters and a playable center.
+// The random generator is seeded with the day number since the calendar epoch, so the same date always gives the same board
+type CurrentTodaysPangram struct {
+	Candidates []Candidate
+	Calendar Calendar
+	Index *Index // used to precompute the answers of every board
+}
 
 func (s CurrentTodaysPangram) TodaysPangram() (GameBoard, error) {
-	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
-	word := s.Words[rng.Intn(len(s.Words))]
-	letters, err := LettersFromWord(word); if err != nil { return GameBoard{}, err }
-	return GameBoard{Letters: letters, Center: letters[rng.Intn(7)], Word: word}, nil
+	return s.PangramFor(s.Calendar.Today())
+}
+
+func (s CurrentTodaysPangram) PangramFor(date time.Time) (GameBoard, error) {
+	day := s.Calendar.Day(date)
+	board, err := s.pick(rand.New(rand.NewSource(int64(s.Calendar.Index(day)))))
+	if err != nil { return GameBoard{}, err }
+	board.Date = day
+	board.Number = s.Calendar.Number(day)
+	return board, nil
 }
 
-// Singleton Board for everyone to read from
+// RandomPangram builds a board that is not tied to any day, so it has no date
+func (s CurrentTodaysPangram) RandomPangram() (GameBoard, error) {
+	return s.pick(rand.New(rand.NewSource(time.Now().UnixNano())))
+}
+
+func (s CurrentTodaysPangram) pick(rng *rand.Rand) (GameBoard, error) {
+	if len(s.Candidates) == 0 { return GameBoard{}, errors.New("NO VALID PANGRAMS LOADED") }
+	candidate := s.Candidates[rng.Intn(len(s.Candidates))]
+	if len(candidate.Centers) == 0 { return GameBoard{}, fmt.Errorf("PANGRAM %q HAS NO PLAYABLE CENTER", candidate.Word) }
+	center := candidate.Centers[rng.Intn(len(candidate.Centers))]
+	board := GameBoard{Letters: candidate.Letters, Center: center, Word: candidate.Word}
+	if s.Index != nil {
+		board.Answers = s.Index.Solve(board.Letters, board.Center)
+		for _, word := range board.Answers {
+			if board.IsPangram(word) { board.Pangrams = append(board.Pangrams, word) }
+		}
+	}
+	return board, nil
+}
+
+// ErrBadDate is returned when a board is asked for a day that can not be played
+var ErrBadDate = errors.New("BOARD DATE NOT AVAILABLE")
+
+// Singleton Board for everyone to read from. The board is kept until the calendar day changes, then the next call builds the new day's board.
+// Games already created hold their own copy of the letters, so they keep playing the board they started with.
 var (
-	once sync.Once
+	mu sync.Mutex
 	global GameBoard
-	err	error
 	src	Source
-	srcSetMu sync.Mutex
+	cal Calendar
+	archive = map[string]GameBoard{}
 )
 
-func InitSource(s Source) {
-	srcSetMu.Lock()
-	defer srcSetMu.Unlock()
+func InitSource(s Source, c Calendar) {
+	mu.Lock()
+	defer mu.Unlock()
 	if src == nil {
 		src = s
+		cal = c
+		archive = map[string]GameBoard{}
 	}
 }
 
-// Board returns the singleton GameBoard
+// Board returns today's GameBoard, rotating it at local midnight
 func Board() (GameBoard, error) {
-	once.Do(func() {
-		if src == nil {
-			err = errors.New("GAME-BOARD SOURCE NOT INITIALIZED")
-			return
-		}
-		global, err = src.TodaysPangram()
-	})
-	return global, err
-}
\ No newline at end of file
+	mu.Lock()
+	defer mu.Unlock()
+	if src == nil { return GameBoard{}, errors.New("GAME-BOARD SOURCE NOT INITIALIZED") }
+	today := cal.Today()
+	if global.Word != "" && global.Date.Equal(today) { return global, nil }
+	board, err := archived(today)
+	if err != nil { return GameBoard{}, err }
+	global = board
+	return global, nil
+}
+
+// BoardFor returns the board of a past day (YYYY-MM-DD in the calendar timezone). Future days are refused so nobody can play tomorrow's puzzle early
+func BoardFor(date string) (GameBoard, error) {
+	mu.Lock()
+	defer mu.Unlock()
+	if src == nil { return GameBoard{}, errors.New("GAME-BOARD SOURCE NOT INITIALIZED") }
+	day, err := time.ParseInLocation(time.DateOnly, date, cal.location())
+	if err != nil { return GameBoard{}, fmt.Errorf("%w: %q, want YYYY-MM-DD", ErrBadDate, date) }
+	if day.After(cal.Today()) { return GameBoard{}, fmt.Errorf("%w: %s is in the future", ErrBadDate, date) }
+	if cal.Index(day) < 0 { return GameBoard{}, fmt.Errorf("%w: %s is before the first puzzle", ErrBadDate, date) }
+	return archived(day)
+}
+
+// RandomBoard returns a board outside of the daily rotation
+func RandomBoard() (GameBoard, error) {
+	mu.Lock()
+	defer mu.Unlock()
+	if src == nil { return GameBoard{}, errors.New("GAME-BOARD SOURCE NOT INITIALIZED") }
+	return src.RandomPangram()
+}
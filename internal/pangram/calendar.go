@@ -0,0 +1,57 @@
+package pangram
+
+import (
+	"time"
+)
+
+// Calendar turns wall-clock time into puzzle days. Every server configured with the same epoch and timezone agrees on what "today" is, so all replicas (and restarts) serve the same daily board.
+type Calendar struct {
+	Epoch    time.Time
+	Location *time.Location
+	Now      func() time.Time
+}
+
+// Build a calendar from an epoch date (YYYY-MM-DD) and an IANA timezone name, e.g. "UTC" or "Europe/Dublin"
+func NewCalendar(epoch string, tz string) (Calendar, error) {
+	loc, err := time.LoadLocation(tz)
+	if err != nil { return Calendar{}, err }
+	start, err := time.ParseInLocation(time.DateOnly, epoch, loc)
+	if err != nil { return Calendar{}, err }
+	return Calendar{Epoch: start, Location: loc, Now: time.Now}, nil
+}
+
+func (c Calendar) location() *time.Location {
+	if c.Location == nil { return time.UTC }
+	return c.Location
+}
+
+// Day truncates a time to local midnight of the calendar timezone
+func (c Calendar) Day(t time.Time) time.Time {
+	y, m, d := t.In(c.location()).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, c.location())
+}
+
+// Today is the puzzle day for the current wall-clock time. Rotates at local midnight.
+func (c Calendar) Today() time.Time {
+	now := time.Now
+	if c.Now != nil { now = c.Now }
+	return c.Day(now())
+}
+
+// Number is the puzzle number of a day, puzzle number one is the epoch
+func (c Calendar) Number(day time.Time) int { return c.Index(day) + 1 }
+
+// DayOf is the day of a puzzle number, counted on calendar dates like Index
+func (c Calendar) DayOf(number int) time.Time {
+	y, m, d := c.Day(c.Epoch).Date()
+	return time.Date(y, m, d+number-1, 0, 0, 0, 0, c.location())
+}
+
+// Index returns how many days passed between the epoch and the given day. Computed on calendar dates so DST changes never skip or repeat a puzzle.
+func (c Calendar) Index(day time.Time) int {
+	y, m, d := c.Day(day).Date()
+	ey, em, ed := c.Day(c.Epoch).Date()
+	from := time.Date(ey, em, ed, 0, 0, 0, 0, time.UTC)
+	to := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	return int(to.Sub(from).Hours() / 24)
+}
@@ -0,0 +1,61 @@
+package pangram
+
+import "sort"
+
+// Hints is the classic hint grid of a board: how many words are left for each first letter and length, and for each two-letter start. It tells a stuck player where to look without giving any word away
+type Hints struct {
+	Words    int // words left to find
+	Pangrams int // pangrams left to find
+	Rows     []HintRow
+	Lengths  []int // word lengths that have words left, the columns of the grid
+	Prefixes []PrefixCount
+}
+
+// HintRow is one line of the grid, the words left that start with Letter
+type HintRow struct {
+	Letter   rune
+	ByLength map[int]int
+	Total    int
+}
+
+// PrefixCount is how many words left start with Prefix
+type PrefixCount struct {
+	Prefix string
+	Count  int
+}
+
+// Hints of the board answers that are not in found. Rows follow the alphabet, prefixes too
+func (b GameBoard) Hints(found []string) Hints {
+	seen := map[string]struct{}{}
+	for _, word := range found { seen[word] = struct{}{} }
+
+	hints := Hints{}
+	rows := map[rune]*HintRow{}
+	lengths := map[int]struct{}{}
+	prefixes := map[string]int{}
+	for _, word := range b.Answers {
+		if _, ok := seen[word]; ok { continue }
+		letters := []rune(word)
+		if len(letters) == 0 { continue }
+		hints.Words++
+		if b.IsPangram(word) { hints.Pangrams++ }
+
+		row, ok := rows[letters[0]]
+		if !ok {
+			row = &HintRow{Letter: letters[0], ByLength: map[int]int{}}
+			rows[letters[0]] = row
+		}
+		row.ByLength[len(letters)]++
+		row.Total++
+		lengths[len(letters)] = struct{}{}
+		if len(letters) >= 2 { prefixes[string(letters[:2])]++ }
+	}
+
+	for _, row := range rows { hints.Rows = append(hints.Rows, *row) }
+	sort.Slice(hints.Rows, func(i, j int) bool { return hints.Rows[i].Letter < hints.Rows[j].Letter })
+	for n := range lengths { hints.Lengths = append(hints.Lengths, n) }
+	sort.Ints(hints.Lengths)
+	for prefix, count := range prefixes { hints.Prefixes = append(hints.Prefixes, PrefixCount{Prefix: prefix, Count: count}) }
+	sort.Slice(hints.Prefixes, func(i, j int) bool { return hints.Prefixes[i].Prefix < hints.Prefixes[j].Prefix })
+	return hints
+}
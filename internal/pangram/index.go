@@ -0,0 +1,65 @@
+package pangram
+
+import (
+	"sort"
+	"strings"
+)
+
+// Index groups dictionary words by the set of letters they use (a 26-bit mask), so every word playable on a board can be found by walking the subsets of the board letters instead of scanning the whole dictionary
+type Index struct {
+	minLength int
+	words     map[uint32][]string
+}
+
+// letter mask of a word, false when the word has anything outside a-z
+func mask(word string) (uint32, bool) {
+	var m uint32
+	for _, r := range word {
+		if r < 'a' || r > 'z' { return 0, false }
+		m |= 1 << uint(r-'a')
+	}
+	return m, true
+}
+
+// Build the index from a word list, ignoring words shorter than the game minimum
+func NewIndex(words []string, minLength int) *Index {
+	idx := &Index{minLength: minLength, words: map[uint32][]string{}}
+	seen := make(map[string]struct{}, len(words))
+	for _, word := range words {
+		word = strings.ToLower(strings.TrimSpace(word))
+		if len(word) < minLength { continue }
+		if _, ok := seen[word]; ok { continue }
+		m, ok := mask(word)
+		if !ok { continue }
+		seen[word] = struct{}{}
+		idx.words[m] = append(idx.words[m], word)
+	}
+	return idx
+}
+
+// walk every group of words that only uses the board letters and contains the center
+func (idx *Index) each(letters []rune, center rune, fn func(words []string)) {
+	full, ok := mask(string(letters))
+	if !ok { return }
+	c, ok := mask(string(center))
+	if !ok || full&c == 0 { return }
+	for sub := full; sub > 0; sub = (sub - 1) & full {
+		if sub&c == 0 { continue }
+		if words, ok := idx.words[sub]; ok { fn(words) }
+	}
+}
+
+// Count how many answers a board has for the given center
+func (idx *Index) Count(letters []rune, center rune) int {
+	n := 0
+	idx.each(letters, center, func(words []string) { n += len(words) })
+	return n
+}
+
+// Solve returns every answer of a board, sorted
+func (idx *Index) Solve(letters []rune, center rune) []string {
+	answers := []string{}
+	idx.each(letters, center, func(words []string) { answers = append(answers, words...) })
+	sort.Strings(answers)
+	return answers
+}
@@ -5,4 +5,16 @@ type Provider struct{}
 
 func (Provider) Board() (GameBoard, error){
 	return Board()
-}
\ No newline at end of file
+}
+
+func (Provider) BoardFor(date string) (GameBoard, error) {
+	return BoardFor(date)
+}
+
+func (Provider) Random() (GameBoard, error) {
+	return RandomBoard()
+}
+
+func (Provider) Puzzle(number int) (GameBoard, error) {
+	return BoardNumber(number)
+}
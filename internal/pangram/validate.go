@@ -0,0 +1,65 @@
+package pangram
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Candidate is a pangram that passed validation, with the center letters that give a playable board
+type Candidate struct {
+	Word    string
+	Letters []rune
+	Centers []rune
+}
+
+// Skipped is a pangram rejected at load time and why
+type Skipped struct {
+	Word   string
+	Reason string
+}
+
+// Validator filters the pangram list before any board is built, so a bad entry is reported when the server starts instead of crashing the first game creation
+type Validator struct {
+	Letters    int    // exact number of distinct letters a board must have
+	MinAnswers int    // minimum dictionary answers a center letter must give
+	Index      *Index // dictionary index, nil skips the answers check
+}
+
+func (v Validator) Validate(words []string) ([]Candidate, []Skipped) {
+	candidates := make([]Candidate, 0, len(words))
+	skipped := []Skipped{}
+	seen := map[string]struct{}{}
+	for _, word := range words {
+		word = strings.ToLower(strings.TrimSpace(word))
+		if _, ok := seen[word]; ok {
+			skipped = append(skipped, Skipped{Word: word, Reason: "DUPLICATE"})
+			continue
+		}
+		seen[word] = struct{}{}
+
+		letters, err := LettersFromWord(word)
+		if err != nil {
+			skipped = append(skipped, Skipped{Word: word, Reason: err.Error()})
+			continue
+		}
+		if len(letters) != v.Letters {
+			skipped = append(skipped, Skipped{Word: word, Reason: fmt.Sprintf("HAS %d DISTINCT LETTERS, WANT %d", len(letters), v.Letters)})
+			continue
+		}
+
+		// Keep only the centers that leave enough words to play with
+		centers := letters
+		if v.Index != nil {
+			centers = make([]rune, 0, len(letters))
+			for _, center := range letters {
+				if v.Index.Count(letters, center) >= v.MinAnswers { centers = append(centers, center) }
+			}
+		}
+		if len(centers) == 0 {
+			skipped = append(skipped, Skipped{Word: word, Reason: fmt.Sprintf("NO CENTER WITH AT LEAST %d ANSWERS", v.MinAnswers)})
+			continue
+		}
+		candidates = append(candidates, Candidate{Word: word, Letters: letters, Centers: centers})
+	}
+	return candidates, skipped
+}
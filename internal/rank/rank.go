@@ -0,0 +1,87 @@
+package rank
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Tier is a named rank reached at a percentage of the board max score
+type Tier struct {
+	Name    string
+	Percent int
+}
+
+// Ladder is the list of tiers from the lowest to the highest. Every server can configure its own ladder, the default one follows the Spelling Bee ranks
+type Ladder []Tier
+
+func Default() Ladder {
+	return Ladder{
+		{Name: "Beginner", Percent: 0},
+		{Name: "Good Start", Percent: 2},
+		{Name: "Moving Up", Percent: 5},
+		{Name: "Good", Percent: 8},
+		{Name: "Solid", Percent: 15},
+		{Name: "Nice", Percent: 25},
+		{Name: "Great", Percent: 40},
+		{Name: "Amazing", Percent: 50},
+		{Name: "Genius", Percent: 70},
+		{Name: "Queen Bee", Percent: 100},
+	}
+}
+
+// Parse a ladder written as "Name:percent" pairs separated by commas, e.g. "Beginner:0,Good:10,Genius:70,Queen Bee:100"
+func Parse(spec string) (Ladder, error) {
+	ladder := Ladder{}
+	for _, part := range strings.Split(spec, ",") {
+		name, percent, ok := strings.Cut(part, ":")
+		if !ok { return nil, fmt.Errorf("RANK %q: want Name:percent", part) }
+		value, err := strconv.Atoi(strings.TrimSpace(percent))
+		if err != nil { return nil, fmt.Errorf("RANK %q: %v", part, err) }
+		ladder = append(ladder, Tier{Name: strings.TrimSpace(name), Percent: value})
+	}
+	if err := ladder.Validate(); err != nil { return nil, err }
+	return ladder, nil
+}
+
+// Validate checks the ladder starts at 0%, climbs strictly and never goes over 100%
+func (l Ladder) Validate() error {
+	if len(l) == 0 { return fmt.Errorf("RANK LADDER IS EMPTY") }
+	if l[0].Percent != 0 { return fmt.Errorf("FIRST RANK %q MUST START AT 0%%", l[0].Name) }
+	for i, tier := range l {
+		if tier.Name == "" { return fmt.Errorf("RANK %d HAS NO NAME", i) }
+		if tier.Percent > 100 { return fmt.Errorf("RANK %q IS OVER 100%%", tier.Name) }
+		if i > 0 && tier.Percent <= l[i-1].Percent { return fmt.Errorf("RANK %q MUST BE HIGHER THAN %q", tier.Name, l[i-1].Name) }
+	}
+	return nil
+}
+
+// Standing is where a score sits on the ladder, and how far the next rank is
+type Standing struct {
+	Name   string
+	Level  int    // index of the tier in the ladder
+	Next   string // empty at the top of the ladder
+	ToNext int
+}
+
+// Points needed to reach a tier on a board
+func (l Ladder) Threshold(level int, maxScore int) int {
+	return int(math.Round(float64(maxScore) * float64(l[level].Percent) / 100))
+}
+
+// At returns the standing of a score on a board with the given max score. Without a max score (no dictionary index) there is nothing to climb
+func (l Ladder) At(score int, maxScore int) Standing {
+	if len(l) == 0 { return Standing{} }
+	if maxScore <= 0 { return Standing{Name: l[0].Name} }
+	level := 0
+	for i := range l {
+		if score >= l.Threshold(i, maxScore) { level = i }
+	}
+	standing := Standing{Name: l[level].Name, Level: level}
+	if level+1 < len(l) {
+		standing.Next = l[level+1].Name
+		standing.ToNext = l.Threshold(level+1, maxScore) - score
+	}
+	return standing
+}
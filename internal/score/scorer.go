@@ -1,5 +1,7 @@
 package score
 
+import "fmt"
+
 // Scorer interface used to implement a strategy pattern
 type Scorer interface { Score(length int, pangram bool) int }
 
@@ -19,3 +21,33 @@ func (b BonusScorer) Score(n int, pangram bool) int {
 	if pangram { return base + b.Bonus }
 	return base
 }
+
+// Scoring strategies a game can choose by name
+const (
+	Basic = "basic"
+	Bonus = "bonus"
+)
+
+func Names() []string { return []string{Basic, Bonus} }
+
+// ByName builds the strategy a game asked for, the bonus is only used by the bonus strategy
+func ByName(name string, bonus int) (Scorer, error) {
+	switch name {
+	case Basic:
+		return BasicScorer{}, nil
+	case Bonus:
+		return BonusScorer{Inner: BasicScorer{}, Bonus: bonus}, nil
+	}
+	return nil, fmt.Errorf("UNKNOWN SCORING %q", name)
+}
+
+// Penalizer is a Scorer that also takes points for a missed word
+type Penalizer interface {
+	Scorer
+	Miss() int
+}
+
+// PenaltyScorer keeps the points of the Inner strategy for words, and takes Penalty points for every miss. It is used by hardcore games, where a word that is not on the board costs a life
+type PenaltyScorer struct { Inner Scorer; Penalty int }
+func (p PenaltyScorer) Score(n int, pangram bool) int { return p.Inner.Score(n, pangram) }
+func (p PenaltyScorer) Miss() int { return p.Penalty }